@@ -114,7 +114,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		&awsCredentials.StaticProvider{Value: awsCredentials.Value{
 			AccessKeyID:     c.AccessKey,
 			SecretAccessKey: c.SecretKey,
-			SessionToken:    c.Token,
+			SessionToken:    c.SecurityToken,
 		}},
 		&awsCredentials.EnvProvider{},
 		&awsCredentials.SharedCredentialsProvider{
@@ -150,6 +150,20 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	}
 	usedEndpoint := setOptionalEndpoint(cfg)
 
+	// Add the temporary credentials of the agency of an OpenTelekomCloud ECS
+	// instance, and of the Keystone token. Both are only retrieved if no
+	// credentials were found before them.
+	providers = append(providers, &s3ECSMetadataCredentialsProvider{
+		Client:   client,
+		Endpoint: s3ECSMetadataEndpoint,
+	})
+	if c.HwClient != nil && !c.Swauth {
+		providers = append(providers, &s3KeystoneCredentialsProvider{
+			Client:   c.iamV30Client(),
+			Duration: s3TemporaryCredentialsDuration,
+		})
+	}
+
 	// Add the default AWS provider for ECS Task Roles if the relevant env variable is set
 	if uri := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); len(uri) > 0 {
 		providers = append(providers, defaults.RemoteCredProvider(*cfg, defaults.Handlers()))
@@ -44,3 +44,9 @@ func retryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}
 	})
 	return resp, err
 }
+
+// isAWSErr reports whether err is an AWS error with the code code.
+func isAWSErr(err error, code string) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == code
+}
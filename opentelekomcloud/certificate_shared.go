@@ -0,0 +1,171 @@
+package opentelekomcloud
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The classic ELB and the load balancer v2 APIs manage certificates with the
+// same attributes, except for the type and the expiry only returned by the
+// latter. The types, the schema and the handling of the attributes they
+// share are defined here.
+
+// Certificate is a certificate of the classic ELB or the load balancer v2
+// API.
+type Certificate struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Domain      string `json:"domain"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"private_key"`
+	ExpireTime  string `json:"expire_time"`
+	CreateTime  string `json:"create_time"`
+	UpdateTime  string `json:"update_time"`
+}
+
+// CertificateOpts contains the attributes of a new or an updated
+// certificate. Type is only supported by the load balancer v2 API.
+type CertificateOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Type        string  `json:"type,omitempty"`
+	Domain      *string `json:"domain,omitempty"`
+	Certificate string  `json:"certificate,omitempty"`
+	PrivateKey  string  `json:"private_key,omitempty"`
+}
+
+// certificateSchema returns the schema of the attributes shared by the
+// certificate resources.
+func certificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"domain": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"certificate": &schema.Schema{
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppressPEMDiff,
+		},
+
+		"private_key": &schema.Schema{
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressPEMDiff,
+		},
+
+		"expire_time": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"create_time": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"update_time": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// certificateCreateOpts returns the shared attributes of a new certificate.
+func certificateCreateOpts(d *schema.ResourceData) CertificateOpts {
+	description := d.Get("description").(string)
+	domain := d.Get("domain").(string)
+	return CertificateOpts{
+		Name:        d.Get("name").(string),
+		Description: &description,
+		Domain:      &domain,
+		Certificate: d.Get("certificate").(string),
+		PrivateKey:  d.Get("private_key").(string),
+	}
+}
+
+// certificateUpdateOpts returns the changed shared attributes of a
+// certificate. The certificate is replaced in place, so listeners using it
+// keep serving and pick up the new one without being recreated.
+func certificateUpdateOpts(d *schema.ResourceData) CertificateOpts {
+	var updateOpts CertificateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("domain") {
+		domain := d.Get("domain").(string)
+		updateOpts.Domain = &domain
+	}
+	if d.HasChange("certificate") || d.HasChange("private_key") {
+		updateOpts.Certificate = d.Get("certificate").(string)
+		updateOpts.PrivateKey = d.Get("private_key").(string)
+	}
+	return updateOpts
+}
+
+// setCertificateAttributes sets the shared attributes of a certificate. The
+// expiry is read from the certificate if the API does not return it.
+func setCertificateAttributes(d *schema.ResourceData, cert *Certificate) {
+	d.Set("name", cert.Name)
+	d.Set("description", cert.Description)
+	d.Set("domain", cert.Domain)
+	d.Set("certificate", cert.Certificate)
+	d.Set("private_key", cert.PrivateKey)
+	d.Set("create_time", cert.CreateTime)
+	d.Set("update_time", cert.UpdateTime)
+
+	expireTime := cert.ExpireTime
+	if expireTime == "" {
+		var err error
+		expireTime, err = certificateExpireTime(cert.Certificate)
+		if err != nil {
+			log.Printf("[WARN] Unable to determine the expiry of certificate %s: %s", d.Id(), err)
+		}
+	}
+	d.Set("expire_time", expireTime)
+}
+
+// certificateExpireTime returns the end of the validity period of the first
+// certificate of the PEM encoded certChain in RFC 3339 format.
+func certificateExpireTime(certChain string) (string, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(certChain)))
+	if block == nil {
+		return "", fmt.Errorf("Unable to decode PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse certificate: %s", err)
+	}
+	return cert.NotAfter.UTC().Format(time.RFC3339), nil
+}
@@ -0,0 +1,56 @@
+package opentelekomcloud
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCertificateExpireTime(t *testing.T) {
+	notAfter := time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC)
+	certChain := testCertificatePEM(t, notAfter) + testCertificatePEM(t, notAfter.AddDate(1, 0, 0))
+
+	expireTime, err := certificateExpireTime("\n" + certChain)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if expireTime != "2030-01-02T03:04:05Z" {
+		t.Fatalf("Expected the expiry of the first certificate, got %s", expireTime)
+	}
+
+	if _, err := certificateExpireTime("not a certificate"); err == nil {
+		t.Fatalf("Expected an error for data which is not PEM encoded")
+	}
+
+	invalid := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")}))
+	if _, err := certificateExpireTime(invalid); err == nil {
+		t.Fatalf("Expected an error for an invalid certificate")
+	}
+}
+
+// testCertificatePEM returns a PEM encoded self-signed certificate which
+// expires at notAfter.
+func testCertificatePEM(t *testing.T, notAfter time.Time) string {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
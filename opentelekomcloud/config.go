@@ -37,6 +37,7 @@ type Config struct {
 	Insecure         bool
 	Password         string
 	Region           string
+	SecurityToken    string
 	Swauth           bool
 	TenantID         string
 	TenantName       string
@@ -133,14 +134,16 @@ func generateTLSConfig(c *Config) (*tls.Config, error) {
 }
 
 func (c *Config) newS3Session(osDebug bool) error {
-	// Don't get AWS session unless we need it for Accesskey, SecretKey.
+	// Setup AWS/S3 client/config information for Swift S3 buckets
+	log.Println("[INFO] Building Swift S3 auth structure")
+	creds, err := GetCredentials(c)
+	if err != nil {
+		return err
+	}
+
+	// Static keys are checked right away. Temporary credentials are only
+	// retrieved once S3 is used, so that other resources work without them.
 	if c.AccessKey != "" && c.SecretKey != "" {
-		// Setup AWS/S3 client/config information for Swift S3 buckets
-		log.Println("[INFO] Building Swift S3 auth structure")
-		creds, err := GetCredentials(c)
-		if err != nil {
-			return err
-		}
 		// Call Get to check for credential provider. If nothing found, we'll get an
 		// error, and we can present it nicely to the user
 		cp, err := creds.Get()
@@ -155,32 +158,32 @@ func (c *Config) newS3Session(osDebug bool) error {
 		}
 
 		log.Printf("[INFO] Swift S3 Auth provider used: %q", cp.ProviderName)
+	}
 
-		awsConfig := &aws.Config{
-			Credentials: creds,
-			Region:      aws.String(c.Region),
-			//MaxRetries:       aws.Int(c.MaxRetries),
-			HTTPClient: cleanhttp.DefaultClient(),
-			//S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
-		}
+	awsConfig := &aws.Config{
+		Credentials: creds,
+		Region:      aws.String(c.Region),
+		//MaxRetries:       aws.Int(c.MaxRetries),
+		HTTPClient: cleanhttp.DefaultClient(),
+		//S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	}
 
-		if osDebug {
-			awsConfig.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
-			awsConfig.Logger = awsLogger{}
-		}
+	if osDebug {
+		awsConfig.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		awsConfig.Logger = awsLogger{}
+	}
 
-		if c.Insecure {
-			transport := awsConfig.HTTPClient.Transport.(*http.Transport)
-			transport.TLSClientConfig = &tls.Config{
-				InsecureSkipVerify: true,
-			}
+	if c.Insecure {
+		transport := awsConfig.HTTPClient.Transport.(*http.Transport)
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
 		}
+	}
 
-		// Set up base session for AWS/Swift S3
-		c.s3sess, err = session.NewSession(awsConfig)
-		if err != nil {
-			return errwrap.Wrapf("Error creating Swift S3 session: {{err}}", err)
-		}
+	// Set up base session for AWS/Swift S3
+	c.s3sess, err = session.NewSession(awsConfig)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Swift S3 session: {{err}}", err)
 	}
 	return nil
 }
@@ -445,6 +448,63 @@ func (c *Config) hwNetworkV2Client(region string) (*golangsdk.ServiceClient, err
 	})
 }
 
+// networkingV3Client returns a client for the v3 VPC API, which is served
+// from the same endpoint as the v1 API.
+func (c *Config) networkingV3Client(region string) (*golangsdk.ServiceClient, error) {
+	sc, err := huaweisdk.NewNetworkV1(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sc.ResourceBase = sc.Endpoint + "v3/"
+	return sc, nil
+}
+
+// imsClient returns a client for the IMS API. The IMS specific requests use
+// both the v1 and the v2 API, so the resource base is left unversioned.
+func (c *Config) imsClient(region string) (*golangsdk.ServiceClient, error) {
+	sc, err := huaweisdk.NewImageServiceV2(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sc.ResourceBase = sc.Endpoint
+	return sc, nil
+}
+
+// iamV30Client returns a client for the v3.0 IAM API, which serves the
+// OpenTelekomCloud extensions of Keystone like temporary credentials.
+func (c *Config) iamV30Client() *golangsdk.ServiceClient {
+	return &golangsdk.ServiceClient{
+		ProviderClient: c.HwClient,
+		Endpoint:       c.HwClient.IdentityBase,
+		ResourceBase:   c.HwClient.IdentityBase + "v3.0/",
+	}
+}
+
+// dehV1Client returns a client for the Dedicated Host API. golangsdk has no
+// constructor for it, so the endpoint is derived from the compute one like
+// the KMS endpoint is.
+func (c *Config) dehV1Client(region string) (*golangsdk.ServiceClient, error) {
+	sc, err := huaweisdk.NewComputeV2(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "ecs", "deh", 1)
+	sc.Endpoint = sc.Endpoint[:strings.LastIndex(sc.Endpoint, "v2")+3]
+	sc.Endpoint = strings.Replace(sc.Endpoint, "v2", "v1.0", 1)
+	sc.ResourceBase = sc.Endpoint + c.HwClient.ProjectID + "/"
+	sc.Type = "deh"
+	return sc, nil
+}
+
 func (c *Config) loadEVSV2Client(region string) (*golangsdk.ServiceClient, error) {
 	return huaweisdk.NewBlockStorageV2(c.HwClient, golangsdk.EndpointOpts{
 		Region:       c.determineRegion(region),
@@ -0,0 +1,104 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeAvailabilityZonesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeAvailabilityZonesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "available",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"available", "unavailable"})
+				},
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"states": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// computeAvailabilityZone is an availability zone as returned by the
+// os-availability-zone API.
+type computeAvailabilityZone struct {
+	ZoneName  string `json:"zoneName"`
+	ZoneState struct {
+		Available bool `json:"available"`
+	} `json:"zoneState"`
+}
+
+func computeV2ListAvailabilityZones(c *gophercloud.ServiceClient) ([]computeAvailabilityZone, error) {
+	var r struct {
+		AvailabilityZoneInfo []computeAvailabilityZone `json:"availabilityZoneInfo"`
+	}
+	_, err := c.Get(c.ServiceURL("os-availability-zone"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.AvailabilityZoneInfo, nil
+}
+
+func dataSourceComputeAvailabilityZonesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	zones, err := computeV2ListAvailabilityZones(computeClient)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve availability zones: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved availability zones: %#v", zones)
+
+	state := d.Get("state").(string)
+	names := []string{}
+	states := make(map[string]interface{}, len(zones))
+	for _, zone := range zones {
+		zoneState := "unavailable"
+		if zone.ZoneState.Available {
+			zoneState = "available"
+		}
+		states[zone.ZoneName] = zoneState
+		if zoneState == state {
+			names = append(names, zone.ZoneName)
+		}
+	}
+	sort.Strings(names)
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(names, ","))))
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving names to state for availability zones: %s", err)
+	}
+	if err := d.Set("states", states); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving states to state for availability zones: %s", err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
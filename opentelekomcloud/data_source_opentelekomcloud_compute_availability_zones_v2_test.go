@@ -0,0 +1,50 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeV2AvailabilityZonesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2AvailabilityZonesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2AvailabilityZonesContains(
+						"data.opentelekomcloud_compute_availability_zones_v2.zones", OS_AVAILABILITY_ZONE),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_compute_availability_zones_v2.zones",
+						"states."+OS_AVAILABILITY_ZONE, "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2AvailabilityZonesContains(n, zone string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "names.") && k != "names.#" && v == zone {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Availability zone %s not found in %s", zone, n)
+	}
+}
+
+const testAccComputeV2AvailabilityZonesDataSource_basic = `
+data "opentelekomcloud_compute_availability_zones_v2" "zones" {}
+`
@@ -0,0 +1,126 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bmsFlavorPrefix is the name prefix of the flavors of bare metal servers.
+const bmsFlavorPrefix = "physical."
+
+func dataSourceComputeBMSFlavorsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeBMSFlavorsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"min_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"swap": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rx_tx_factor": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeBMSFlavorsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	listOpts := flavors.ListOpts{
+		MinRAM:  d.Get("min_ram").(int),
+		MinDisk: d.Get("min_disk").(int),
+	}
+
+	allPages, err := flavors.ListDetail(computeClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query flavors: %s", err)
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve flavors: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	vcpus := d.Get("vcpus").(int)
+
+	var matches []flavors.Flavor
+	for _, flavor := range allFlavors {
+		if !strings.HasPrefix(flavor.Name, bmsFlavorPrefix) {
+			continue
+		}
+		if name != "" && flavor.Name != name {
+			continue
+		}
+		if vcpus != 0 && flavor.VCPUs != vcpus {
+			continue
+		}
+		matches = append(matches, flavor)
+	}
+
+	if len(matches) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(matches) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", matches)
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	flavor := matches[0]
+	log.Printf("[DEBUG] Retrieved bare metal flavor %s: %+v", flavor.ID, flavor)
+
+	d.SetId(flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("vcpus", flavor.VCPUs)
+	d.Set("ram", flavor.RAM)
+	d.Set("disk", flavor.Disk)
+	d.Set("swap", flavor.Swap)
+	d.Set("rx_tx_factor", flavor.RxTxFactor)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
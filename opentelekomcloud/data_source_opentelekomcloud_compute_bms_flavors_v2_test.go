@@ -0,0 +1,34 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2BMSFlavorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckBMS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2BMSFlavorsDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_compute_bms_flavors_v2.flavor", "name", OS_BMS_FLAVOR_NAME),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_compute_bms_flavors_v2.flavor", "vcpus"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_compute_bms_flavors_v2.flavor", "ram"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2BMSFlavorsDataSource_basic = fmt.Sprintf(`
+data "opentelekomcloud_compute_bms_flavors_v2" "flavor" {
+  name = "%s"
+}
+`, OS_BMS_FLAVOR_NAME)
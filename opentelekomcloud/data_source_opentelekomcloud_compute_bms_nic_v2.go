@@ -0,0 +1,118 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/attachinterfaces"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeBMSNicV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeBMSNicV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"mac_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fixed_ips": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceComputeBMSNicV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	serverId := d.Get("server_id").(string)
+	allPages, err := attachinterfaces.List(computeClient, serverId).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query NICs of bare metal server (%s): %s", serverId, err)
+	}
+
+	allNics, err := attachinterfaces.ExtractInterfaces(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve NICs of bare metal server (%s): %s", serverId, err)
+	}
+
+	id := d.Get("id").(string)
+	networkId := d.Get("network_id").(string)
+	status := d.Get("status").(string)
+
+	var matches []attachinterfaces.Interface
+	for _, nic := range allNics {
+		if id != "" && nic.PortID != id {
+			continue
+		}
+		if networkId != "" && nic.NetID != networkId {
+			continue
+		}
+		if status != "" && nic.PortState != status {
+			continue
+		}
+		matches = append(matches, nic)
+	}
+
+	if len(matches) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(matches) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", matches)
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	nic := matches[0]
+	log.Printf("[DEBUG] Retrieved NIC %s of bare metal server %s: %+v", nic.PortID, serverId, nic)
+
+	fixedIPs := make([]string, 0, len(nic.FixedIPs))
+	for _, ip := range nic.FixedIPs {
+		fixedIPs = append(fixedIPs, ip.IPAddress)
+	}
+
+	d.SetId(nic.PortID)
+	d.Set("network_id", nic.NetID)
+	d.Set("status", nic.PortState)
+	d.Set("mac_address", nic.MACAddr)
+	if err := d.Set("fixed_ips", fixedIPs); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving fixed_ips to state for NIC (%s): %s", nic.PortID, err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
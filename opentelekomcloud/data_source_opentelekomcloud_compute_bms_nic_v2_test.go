@@ -0,0 +1,41 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2BMSNicDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckBMS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2BMSNicDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_compute_bms_nic_v2.nic", "network_id", OS_NETWORK_ID),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_compute_bms_nic_v2.nic", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_compute_bms_nic_v2.nic", "mac_address",
+						"opentelekomcloud_compute_bms_server_v2.server_1", "network.0.mac"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_compute_bms_nic_v2.nic", "fixed_ips.0",
+						"opentelekomcloud_compute_bms_server_v2.server_1", "network.0.fixed_ip_v4"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2BMSNicDataSource_basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_compute_bms_nic_v2" "nic" {
+  server_id = "${opentelekomcloud_compute_bms_server_v2.server_1.id}"
+  network_id = "%s"
+}
+`, testAccComputeV2BMSServer_basic, OS_NETWORK_ID)
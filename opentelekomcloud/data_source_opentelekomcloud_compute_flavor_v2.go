@@ -0,0 +1,232 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeFlavorV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"min_vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"min_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"performance_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"generation": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"smallest": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"availability_zones": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceComputeFlavorV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadECSV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ECS client: %s", err)
+	}
+
+	az := d.Get("availability_zone").(string)
+	allFlavors, err := ecsV1ListFlavors(client, az)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve flavors: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	vcpus := d.Get("vcpus").(int)
+	minVCPUs := d.Get("min_vcpus").(int)
+	ram := d.Get("ram").(int)
+	minRAM := d.Get("min_ram").(int)
+	disk := d.Get("disk").(int)
+	performanceType := d.Get("performance_type").(string)
+	generation := d.Get("generation").(string)
+
+	var matches []computeFlavorV2
+	for _, f := range allFlavors {
+		flavor := newComputeFlavorV2(f)
+		if name != "" && flavor.Name != name {
+			continue
+		}
+		if (vcpus != 0 && flavor.VCPUs != vcpus) || flavor.VCPUs < minVCPUs {
+			continue
+		}
+		if (ram != 0 && flavor.RAM != ram) || flavor.RAM < minRAM {
+			continue
+		}
+		if disk != 0 && flavor.Disk != disk {
+			continue
+		}
+		if performanceType != "" && flavor.ExtraSpecs.PerformanceType != performanceType {
+			continue
+		}
+		if generation != "" && flavor.ExtraSpecs.Generation != generation {
+			continue
+		}
+		if az != "" && !flavor.availableIn(az) {
+			continue
+		}
+		matches = append(matches, flavor)
+	}
+
+	if len(matches) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(matches) > 1 {
+		if !d.Get("smallest").(bool) {
+			log.Printf("[DEBUG] Multiple results found: %#v", matches)
+			return fmt.Errorf("Your query returned more than one result. " +
+				"Please try a more specific search criteria, or set smallest to true.")
+		}
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].less(matches[j])
+		})
+	}
+
+	flavor := matches[0]
+	log.Printf("[DEBUG] Retrieved flavor %s: %+v", flavor.ID, flavor)
+
+	d.SetId(flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("vcpus", flavor.VCPUs)
+	d.Set("ram", flavor.RAM)
+	d.Set("disk", flavor.Disk)
+	d.Set("performance_type", flavor.ExtraSpecs.PerformanceType)
+	d.Set("generation", flavor.ExtraSpecs.Generation)
+	if err := d.Set("availability_zones", flavor.availabilityZones()); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving availability_zones to state for flavor (%s): %s", flavor.ID, err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// computeFlavorV2 is a CloudServerFlavor with the numeric attributes parsed.
+type computeFlavorV2 struct {
+	CloudServerFlavor
+	VCPUs int
+	Disk  int
+	// zones maps the availability zones the flavor is listed in to its
+	// status there.
+	zones map[string]string
+}
+
+func newComputeFlavorV2(f CloudServerFlavor) computeFlavorV2 {
+	flavor := computeFlavorV2{
+		CloudServerFlavor: f,
+		zones:             make(map[string]string),
+	}
+	flavor.VCPUs, _ = strconv.Atoi(f.VCPUs)
+	flavor.Disk, _ = strconv.Atoi(f.Disk)
+
+	for _, entry := range strings.Split(f.ExtraSpecs.OperationAZ, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		zone, status := entry, f.ExtraSpecs.OperationStatus
+		if i := strings.Index(entry, "("); i > 0 && strings.HasSuffix(entry, ")") {
+			zone, status = entry[:i], entry[i+1:len(entry)-1]
+		}
+		flavor.zones[zone] = status
+	}
+
+	return flavor
+}
+
+// computeFlavorV2OnSale reports whether a flavor with the given operation
+// status can be used for new instances.
+func computeFlavorV2OnSale(status string) bool {
+	return status == "" || status == "normal" || status == "promotion"
+}
+
+// availableIn reports whether new instances of the flavor can be launched in
+// the availability zone az.
+func (f computeFlavorV2) availableIn(az string) bool {
+	if status, ok := f.zones[az]; ok {
+		return computeFlavorV2OnSale(status)
+	}
+	return len(f.zones) == 0 && computeFlavorV2OnSale(f.ExtraSpecs.OperationStatus)
+}
+
+// availabilityZones returns the sorted names of the availability zones the
+// flavor is listed as available in.
+func (f computeFlavorV2) availabilityZones() []string {
+	zones := make([]string, 0, len(f.zones))
+	for zone, status := range f.zones {
+		if computeFlavorV2OnSale(status) {
+			zones = append(zones, zone)
+		}
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+// less orders flavors by vCPUs, then RAM, then disk size.
+func (f computeFlavorV2) less(other computeFlavorV2) bool {
+	if f.VCPUs != other.VCPUs {
+		return f.VCPUs < other.VCPUs
+	}
+	if f.RAM != other.RAM {
+		return f.RAM < other.RAM
+	}
+	return f.Disk < other.Disk
+}
@@ -0,0 +1,68 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2FlavorDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2FlavorDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_compute_flavor_v2.flavor", "vcpus", "2"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_compute_flavor_v2.flavor", "ram", "4096"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_compute_flavor_v2.flavor", "performance_type", "normal"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_compute_flavor_v2.flavor", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2FlavorDataSource_smallest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2FlavorDataSource_smallest,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_compute_flavor_v2.flavor", "vcpus", "2"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_compute_flavor_v2.flavor", "name"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_compute_flavor_v2.flavor", "availability_zones.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeV2FlavorDataSource_basic = `
+data "opentelekomcloud_compute_flavor_v2" "flavor" {
+  vcpus = 2
+  ram = 4096
+  performance_type = "normal"
+  generation = "s2"
+}
+`
+
+var testAccComputeV2FlavorDataSource_smallest = fmt.Sprintf(`
+data "opentelekomcloud_compute_flavor_v2" "flavor" {
+  min_vcpus = 2
+  min_ram = 2048
+  availability_zone = "%s"
+  smallest = true
+}
+`, OS_AVAILABILITY_ZONE)
@@ -0,0 +1,140 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDCConnectionV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDCConnectionV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"location": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_location": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vlan": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDCConnectionV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	allConnections, err := dcV2ListDirectConnects(dcClient)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve direct connect connections: %s", err)
+	}
+
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	status := d.Get("status").(string)
+
+	var refinedConnections []DirectConnect
+	for _, conn := range allConnections {
+		if id != "" && conn.ID != id {
+			continue
+		}
+		if name != "" && conn.Name != name {
+			continue
+		}
+		if status != "" && conn.Status != status {
+			continue
+		}
+		refinedConnections = append(refinedConnections, conn)
+	}
+
+	if len(refinedConnections) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedConnections) > 1 {
+		return fmt.Errorf("Multiple direct connect connections matched." +
+			" Use additional constraints to reduce matches to a single connection")
+	}
+
+	conn := refinedConnections[0]
+
+	log.Printf("[INFO] Retrieved direct connect connection using given filter %s: %+v", conn.ID, conn)
+	d.SetId(conn.ID)
+
+	d.Set("id", conn.ID)
+	d.Set("name", conn.Name)
+	d.Set("status", conn.Status)
+	d.Set("description", conn.Description)
+	d.Set("bandwidth", conn.Bandwidth)
+	d.Set("location", conn.Location)
+	d.Set("peer_location", conn.PeerLocation)
+	d.Set("port_type", conn.PortType)
+	d.Set("provider_name", conn.Provider)
+	d.Set("provider_status", conn.ProviderStatus)
+	d.Set("type", conn.Type)
+	d.Set("vlan", conn.VLAN)
+	d.Set("tenant_id", conn.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDCConnectionV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDC(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDCConnectionV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDCConnectionV2DataSourceID("data.opentelekomcloud_dc_connection_v2.by_id"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_dc_connection_v2.by_id", "id", OS_DC_CONNECTION_ID),
+					testAccCheckDCConnectionV2DataSourceID("data.opentelekomcloud_dc_connection_v2.by_name"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_dc_connection_v2.by_name", "id",
+						"data.opentelekomcloud_dc_connection_v2.by_id", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDCConnectionV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find direct connect connection data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Direct connect connection data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccDCConnectionV2DataSource_basic = fmt.Sprintf(`
+data "opentelekomcloud_dc_connection_v2" "by_id" {
+  id = "%s"
+}
+
+data "opentelekomcloud_dc_connection_v2" "by_name" {
+  name = "${data.opentelekomcloud_dc_connection_v2.by_id.name}"
+}
+`, OS_DC_CONNECTION_ID)
@@ -0,0 +1,104 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDehHostV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDehHostV1Read,
+
+		Schema: resourceDehHostV1ComputedSchema(map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"host_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"host_type_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"flavor": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"auto_placement": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+func dataSourceDehHostV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.dehV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DeH client: %s", err)
+	}
+
+	listOpts := DedicatedHostListOpts{
+		ID:               d.Get("id").(string),
+		Name:             d.Get("name").(string),
+		HostType:         d.Get("host_type").(string),
+		HostTypeName:     d.Get("host_type_name").(string),
+		Flavor:           d.Get("flavor").(string),
+		State:            d.Get("state").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	hosts, err := dehV1ListHosts(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve dedicated hosts: %s", err)
+	}
+
+	if len(hosts) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(hosts) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	host := hosts[0]
+	log.Printf("[INFO] Retrieved dedicated host using given filter %s: %+v", host.ID, host)
+
+	d.SetId(host.ID)
+	d.Set("name", host.Name)
+	d.Set("host_type", host.HostProperties.HostType)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("region", GetRegion(d, config))
+
+	return setDehHostV1Attributes(d, &host)
+}
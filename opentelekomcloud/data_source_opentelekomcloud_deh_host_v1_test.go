@@ -0,0 +1,52 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDehHostV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDeh(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDehHostV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_deh_host_v1.by_id", "id",
+						"opentelekomcloud_deh_host_v1.deh_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_deh_host_v1.by_name", "id",
+						"opentelekomcloud_deh_host_v1.deh_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_deh_host_v1.by_id", "host_type", OS_DEH_HOST_TYPE),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_deh_host_v1.by_id", "instance_total", "0"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_deh_host_v1.by_id", "available_vcpus"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_deh_host_v1.by_id", "available_memory"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDehHostV1DataSource_basic = fmt.Sprintf(`
+resource "opentelekomcloud_deh_host_v1" "deh_1" {
+  name = "deh_data_source"
+  availability_zone = "%s"
+  host_type = "%s"
+}
+
+data "opentelekomcloud_deh_host_v1" "by_id" {
+  id = "${opentelekomcloud_deh_host_v1.deh_1.id}"
+}
+
+data "opentelekomcloud_deh_host_v1" "by_name" {
+  name = "${opentelekomcloud_deh_host_v1.deh_1.name}"
+}
+`, OS_AVAILABILITY_ZONE, OS_DEH_HOST_TYPE)
@@ -40,6 +40,15 @@ func dataSourceImagesImageV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"member_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"accepted", "pending", "rejected", "all"})
+				},
+			},
+
 			"size_min": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -150,15 +159,16 @@ func dataSourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error
 	visibility := resourceImagesImageV2VisibilityFromString(d.Get("visibility").(string))
 
 	listOpts := images.ListOpts{
-		Name:       d.Get("name").(string),
-		Visibility: visibility,
-		Owner:      d.Get("owner").(string),
-		Status:     images.ImageStatusActive,
-		SizeMin:    int64(d.Get("size_min").(int)),
-		SizeMax:    int64(d.Get("size_max").(int)),
-		SortKey:    d.Get("sort_key").(string),
-		SortDir:    d.Get("sort_direction").(string),
-		Tag:        d.Get("tag").(string),
+		Name:         d.Get("name").(string),
+		Visibility:   visibility,
+		MemberStatus: images.ImageMemberStatus(d.Get("member_status").(string)),
+		Owner:        d.Get("owner").(string),
+		Status:       images.ImageStatusActive,
+		SizeMin:      int64(d.Get("size_min").(int)),
+		SizeMax:      int64(d.Get("size_max").(int)),
+		SortKey:      d.Get("sort_key").(string),
+		SortDir:      d.Get("sort_direction").(string),
+		Tag:          d.Get("tag").(string),
 	}
 
 	log.Printf("[DEBUG] List Options: %#v", listOpts)
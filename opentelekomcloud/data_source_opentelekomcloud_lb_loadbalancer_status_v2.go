@@ -0,0 +1,182 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLBLoadBalancerStatusV2() *schema.Resource {
+	memberSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"operating_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioning_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	poolSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioning_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"healthmonitor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     memberSchema,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceLBLoadBalancerStatusV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"loadbalancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioning_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"listeners": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisioning_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pools": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     poolSchema,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBLoadBalancerStatusV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	tree, err := lbV2GetStatusTree(networkingClient, lbID)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve status tree of load balancer %s: %s", lbID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved status tree of load balancer %s: %#v", lbID, tree)
+
+	listeners := make([]map[string]interface{}, len(tree.Listeners))
+	for i, listener := range tree.Listeners {
+		pools := make([]map[string]interface{}, len(listener.Pools))
+		for j, pool := range listener.Pools {
+			members := make([]map[string]interface{}, len(pool.Members))
+			for k, member := range pool.Members {
+				members[k] = map[string]interface{}{
+					"id":                  member.ID,
+					"address":             member.Address,
+					"protocol_port":       member.ProtocolPort,
+					"operating_status":    member.OperatingStatus,
+					"provisioning_status": member.ProvisioningStatus,
+				}
+			}
+			pools[j] = map[string]interface{}{
+				"id":                  pool.ID,
+				"name":                pool.Name,
+				"operating_status":    pool.OperatingStatus,
+				"provisioning_status": pool.ProvisioningStatus,
+				"healthmonitor_id":    pool.HealthMonitor.ID,
+				"members":             members,
+			}
+		}
+		listeners[i] = map[string]interface{}{
+			"id":                  listener.ID,
+			"name":                listener.Name,
+			"operating_status":    listener.OperatingStatus,
+			"provisioning_status": listener.ProvisioningStatus,
+			"pools":               pools,
+		}
+	}
+
+	d.SetId(lbID)
+	d.Set("name", tree.Name)
+	d.Set("operating_status", tree.OperatingStatus)
+	d.Set("provisioning_status", tree.ProvisioningStatus)
+	if err := d.Set("listeners", listeners); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving listeners to state for load balancer (%s): %s", lbID, err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
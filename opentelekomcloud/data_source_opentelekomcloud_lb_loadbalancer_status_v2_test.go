@@ -0,0 +1,84 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLBV2LoadBalancerStatusDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2LoadBalancerStatusDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_lb_loadbalancer_status_v2.status_1", "id",
+						"opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_lb_loadbalancer_status_v2.status_1", "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_lb_loadbalancer_status_v2.status_1", "listeners.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.healthmonitor_id",
+						"opentelekomcloud_lb_monitor_v2.monitor_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.members.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.members.0.address", "192.168.199.10"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.members.0.operating_status"),
+				),
+			},
+		},
+	})
+}
+
+var TestAccLBV2LoadBalancerStatusDataSourceConfig_basic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+}
+
+resource "opentelekomcloud_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "HTTP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+}
+
+resource "opentelekomcloud_lb_member_v2" "member_1" {
+  address = "192.168.199.10"
+  protocol_port = 8080
+  pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+  subnet_id = "%s"
+}
+
+data "opentelekomcloud_lb_loadbalancer_status_v2" "status_1" {
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+
+  depends_on = [
+    "opentelekomcloud_lb_monitor_v2.monitor_1",
+    "opentelekomcloud_lb_member_v2.member_1",
+  ]
+}
+`, OS_SUBNET_ID, OS_SUBNET_ID)
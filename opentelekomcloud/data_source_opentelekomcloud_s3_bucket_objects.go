@@ -0,0 +1,175 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// s3ListObjectsLimit is the maximum number of keys a ListObjects request
+// returns.
+const s3ListObjectsLimit = 1000
+
+func dataSourceS3BucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceS3BucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delimiter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_after": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_keys": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value < 0 {
+						errors = append(errors, fmt.Errorf(
+							"Only numbers greater than or equal to 0 are supported values for 'max_keys'"))
+					}
+					return
+				},
+			},
+			"keys": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"common_prefixes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"objects": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"etag": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_class": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	maxKeys := d.Get("max_keys").(int)
+
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+	}
+	if v, ok := d.GetOk("prefix"); ok {
+		input.Prefix = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("delimiter"); ok {
+		input.Delimiter = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("start_after"); ok {
+		input.Marker = aws.String(v.(string))
+	}
+	if maxKeys > 0 && maxKeys < s3ListObjectsLimit {
+		input.MaxKeys = aws.Int64(int64(maxKeys))
+	}
+
+	keys := []string{}
+	commonPrefixes := []string{}
+	objects := []map[string]interface{}{}
+
+	// The keys and common prefixes both count towards max_keys, as they do
+	// for a single request.
+	full := func() bool {
+		return maxKeys > 0 && len(keys)+len(commonPrefixes) >= maxKeys
+	}
+
+	log.Printf("[DEBUG] Listing S3 objects: %s", input)
+	err = conn.ListObjectsPages(input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			if full() {
+				return false
+			}
+			key := aws.StringValue(object.Key)
+			keys = append(keys, key)
+			// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+			etag := strings.Trim(aws.StringValue(object.ETag), `"`)
+			objects = append(objects, map[string]interface{}{
+				"key":           key,
+				"size":          int(aws.Int64Value(object.Size)),
+				"etag":          etag,
+				"last_modified": aws.TimeValue(object.LastModified).Format(time.RFC1123),
+				"storage_class": s3StorageClassName(aws.StringValue(object.StorageClass)),
+			})
+		}
+		for _, commonPrefix := range page.CommonPrefixes {
+			if full() {
+				return false
+			}
+			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+		}
+		return !full()
+	})
+	if err != nil {
+		return fmt.Errorf("Failed listing S3 objects of bucket %q: %s", bucket, err)
+	}
+
+	log.Printf("[DEBUG] Received %d S3 objects and %d common prefixes of bucket %q",
+		len(keys), len(commonPrefixes), bucket)
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(bucket+":"+strings.Join(keys, ",")+":"+strings.Join(commonPrefixes, ","))))
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving keys to state for S3 objects of bucket %q: %s", bucket, err)
+	}
+	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving common_prefixes to state for S3 objects of bucket %q: %s", bucket, err)
+	}
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving objects to state for S3 objects of bucket %q: %s", bucket, err)
+	}
+
+	return nil
+}
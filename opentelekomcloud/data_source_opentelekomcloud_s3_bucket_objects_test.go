@@ -0,0 +1,88 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceS3BucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceOnlyConf, conf := testAccDataSourceS3BucketObjectsConfig_basic(rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: resourceOnlyConf,
+			},
+			resource.TestStep{
+				Config: conf,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "keys.0", "releases/v1.0/app.sha256"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "objects.#", "3"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "objects.1.size", "11"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "objects.1.etag", "b10a8db164e0754105b7a99be72e3fe5"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "objects.1.storage_class", "STANDARD"),
+					resource.TestMatchResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "objects.1.last_modified",
+						regexp.MustCompile("^[a-zA-Z]{3}, [0-9]+ [a-zA-Z]+ [0-9]{4} [0-9:]+ [A-Z]+$")),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.dirs", "keys.#", "0"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.dirs", "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.dirs", "common_prefixes.1", "releases/v1.1/"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.after", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.after", "keys.0", "releases/v1.1/app.zip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceS3BucketObjectsConfig_basic(randInt int) (string, string) {
+	resources := fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "objects_bucket" {
+	bucket = "tf-objects-test-bucket-%d"
+}
+resource "opentelekomcloud_s3_bucket_object" "object_1" {
+	bucket = "${opentelekomcloud_s3_bucket.objects_bucket.bucket}"
+	key = "releases/v1.0/app.zip"
+	content = "Hello World"
+}
+resource "opentelekomcloud_s3_bucket_object" "object_2" {
+	bucket = "${opentelekomcloud_s3_bucket.objects_bucket.bucket}"
+	key = "releases/v1.0/app.sha256"
+	content = "checksum"
+}
+resource "opentelekomcloud_s3_bucket_object" "object_3" {
+	bucket = "${opentelekomcloud_s3_bucket.objects_bucket.bucket}"
+	key = "releases/v1.1/app.zip"
+	content = "Hello World!"
+}
+`, randInt)
+
+	both := fmt.Sprintf(`%s
+data "opentelekomcloud_s3_bucket_objects" "all" {
+	bucket = "tf-objects-test-bucket-%d"
+	prefix = "releases/"
+}
+
+data "opentelekomcloud_s3_bucket_objects" "dirs" {
+	bucket = "tf-objects-test-bucket-%d"
+	prefix = "releases/"
+	delimiter = "/"
+}
+
+data "opentelekomcloud_s3_bucket_objects" "after" {
+	bucket = "tf-objects-test-bucket-%d"
+	prefix = "releases/"
+	start_after = "releases/v1.0/app.zip"
+	max_keys = 1
+}`, resources, randInt, randInt, randInt)
+
+	return resources, both
+}
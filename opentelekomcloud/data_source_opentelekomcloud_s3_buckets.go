@@ -0,0 +1,93 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceS3Buckets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceS3BucketsRead,
+
+		Schema: map[string]*schema.Schema{
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"buckets": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceS3BucketsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	out, err := conn.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		return fmt.Errorf("Failed listing S3 buckets: %s", err)
+	}
+
+	log.Printf("[DEBUG] Received S3 buckets: %s", out)
+
+	prefix := d.Get("prefix").(string)
+	names := []string{}
+	creationDates := make(map[string]time.Time)
+	for _, bucket := range out.Buckets {
+		name := aws.StringValue(bucket.Name)
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+			creationDates[name] = aws.TimeValue(bucket.CreationDate)
+		}
+	}
+	sort.Strings(names)
+
+	buckets := make([]map[string]interface{}, len(names))
+	for i, name := range names {
+		buckets[i] = map[string]interface{}{
+			"name":          name,
+			"creation_date": creationDates[name].Format(time.RFC1123),
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(names, ","))))
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving names to state for S3 buckets: %s", err)
+	}
+	if err := d.Set("buckets", buckets); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving buckets to state for S3 buckets: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceS3Buckets_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceOnlyConf, conf := testAccDataSourceS3BucketsConfig_basic(rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: resourceOnlyConf,
+			},
+			resource.TestStep{
+				Config: conf,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_buckets.buckets", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_buckets.buckets", "names.0",
+						fmt.Sprintf("tf-buckets-test-%d-a", rInt)),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_buckets.buckets", "buckets.#", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_buckets.buckets", "buckets.1.name",
+						fmt.Sprintf("tf-buckets-test-%d-b", rInt)),
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_s3_buckets.buckets", "buckets.1.creation_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceS3BucketsConfig_basic(randInt int) (string, string) {
+	resources := fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket_a" {
+	bucket = "tf-buckets-test-%d-a"
+}
+resource "opentelekomcloud_s3_bucket" "bucket_b" {
+	bucket = "tf-buckets-test-%d-b"
+}
+`, randInt, randInt)
+
+	both := fmt.Sprintf(`%s
+data "opentelekomcloud_s3_buckets" "buckets" {
+	prefix = "tf-buckets-test-%d-"
+}`, resources, randInt)
+
+	return resources, both
+}
@@ -23,6 +23,10 @@ func dataSourceVpcSubnetIdsV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
 			"ids": &schema.Schema{
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -46,6 +50,18 @@ func dataSourceVpcSubnetIdsV1Read(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Unable to retrieve subnets: %s", err)
 	}
 
+	if wantedTags := d.Get("tags").(map[string]interface{}); len(wantedTags) > 0 {
+		tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		refinedSubnets, err = filterVpcSubnetsV1ByTags(tagClient, refinedSubnets, wantedTags)
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(refinedSubnets) == 0 {
 		return fmt.Errorf("no matching subnet found for vpc with id %s", d.Get("vpc_id").(string))
 	}
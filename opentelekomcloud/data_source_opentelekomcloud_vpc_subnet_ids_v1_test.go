@@ -27,6 +27,22 @@ func TestAccOTCVpcSubnetIdsV2DataSource_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccOTCVpcSubnetIdsV2DataSource_tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOTCSubnetIdV2DataSource_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccOTCSubnetIdV2DataSourceID("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccOTCSubnetIdV2DataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -62,3 +78,40 @@ data "opentelekomcloud_vpc_subnet_ids_v1" "subnet_ids" {
   vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
 }
 `, testAccOTCSubnetIdV2DataSource_vpcsubnet)
+
+const testAccOTCSubnetIdV2DataSource_tags = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+	name = "test_vpc"
+	cidr= "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name = "opentelekomcloud_subnet_1"
+  cidr = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+
+  tags {
+    role = "frontend"
+  }
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_2" {
+  name = "opentelekomcloud_subnet_2"
+  cidr = "192.168.1.0/24"
+  gateway_ip = "192.168.1.1"
+  vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+
+  tags {
+    role = "backend"
+  }
+}
+
+data "opentelekomcloud_vpc_subnet_ids_v1" "subnet_ids" {
+  vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+
+  tags {
+    role = "${opentelekomcloud_vpc_subnet_v1.subnet_2.tags.role}"
+  }
+}
+`
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v1/subnets"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -67,6 +68,39 @@ func dataSourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"ipv6_enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cidr_v6": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_ip_v6": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ntp_addresses": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dhcp_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dhcp_lease_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -92,6 +126,18 @@ func dataSourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to retrieve subnets: %s", err)
 	}
 
+	tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if wantedTags := d.Get("tags").(map[string]interface{}); len(wantedTags) > 0 {
+		refinedSubnets, err = filterVpcSubnetsV1ByTags(tagClient, refinedSubnets, wantedTags)
+		if err != nil {
+			return err
+		}
+	}
+
 	if refinedSubnets == nil || len(refinedSubnets) == 0 {
 		return fmt.Errorf("No matching subnet found. " +
 			"Please change your search criteria and try again.")
@@ -118,5 +164,33 @@ func dataSourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("vpc_id", Subnets.VPC_ID)
 	d.Set("region", GetRegion(d, config))
 
+	ext, err := getVpcSubnetV1Extension(subnets.Get(subnetClient, Subnets.ID))
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve subnet %s: %s", Subnets.ID, err)
+	}
+	setVpcSubnetV1Extension(d, ext)
+
+	tags, err := resourceVpcTagsV2Get(tagClient, "subnets", Subnets.ID)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve tags of subnet %s: %s", Subnets.ID, err)
+	}
+	d.Set("tags", tags)
+
 	return nil
 }
+
+// filterVpcSubnetsV1ByTags returns the subnets which carry all the wanted tags.
+// It retrieves the tags of every subnet, so it is only called with a filter.
+func filterVpcSubnetsV1ByTags(tagClient *golangsdk.ServiceClient, allSubnets []subnets.Subnet, wanted map[string]interface{}) ([]subnets.Subnet, error) {
+	var refined []subnets.Subnet
+	for _, subnet := range allSubnets {
+		tags, err := resourceVpcTagsV2Get(tagClient, "subnets", subnet.ID)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to retrieve tags of subnet %s: %s", subnet.ID, err)
+		}
+		if vpcTagsMatch(tags, wanted) {
+			refined = append(refined, subnet)
+		}
+	}
+	return refined, nil
+}
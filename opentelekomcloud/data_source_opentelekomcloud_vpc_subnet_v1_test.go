@@ -35,6 +35,27 @@ func TestAccOTCVpcSubnetV1DataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcSubnetV1DataSource_tags(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOTCVpcSubnetV1Config_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceOTCVpcSubnetV1Check("data.opentelekomcloud_vpc_subnet_v1.by_tags", "opentelekomcloud_subnet_2", "192.168.1.0/24",
+						"192.168.1.1", "eu-de-02"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_vpc_subnet_v1.by_tags", "ntp_addresses", "10.100.0.33"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_vpc_subnet_v1.by_tags", "tags.role", "backend"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceOTCVpcSubnetV1Check(n, name, cidr, gateway_ip, availability_zone string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -104,3 +125,43 @@ data "opentelekomcloud_vpc_subnet_v1" "by_vpc_id" {
 	vpc_id = "${opentelekomcloud_vpc_subnet_v1.subnet_1.vpc_id}"
 }
 `
+
+const testAccDataSourceOTCVpcSubnetV1Config_tags = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+	name = "test_vpc"
+	cidr= "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name = "opentelekomcloud_subnet_1"
+  cidr = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  availability_zone = "eu-de-02"
+
+  tags {
+    role = "frontend"
+  }
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_2" {
+  name = "opentelekomcloud_subnet_2"
+  cidr = "192.168.1.0/24"
+  gateway_ip = "192.168.1.1"
+  vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  availability_zone = "eu-de-02"
+  ntp_addresses = "10.100.0.33"
+
+  tags {
+    role = "backend"
+  }
+}
+
+data "opentelekomcloud_vpc_subnet_v1" "by_tags" {
+  vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+
+  tags {
+    role = "${opentelekomcloud_vpc_subnet_v1.subnet_2.tags.role}"
+  }
+}
+`
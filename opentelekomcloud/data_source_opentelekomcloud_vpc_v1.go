@@ -40,6 +40,7 @@ func dataSourceVirtualPrivateCloudVpcV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"tags": tagsSchemaComputed(),
 			"routes": &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
@@ -76,6 +77,30 @@ func dataSourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Unable to retrieve vpcs: %s", err)
 	}
 
+	// The tags are only looked up to filter by them, the matching tags of the
+	// VPC found are kept for the tags attribute.
+	var matchedTags map[string]string
+	wantedTags := d.Get("tags").(map[string]interface{})
+	if len(wantedTags) > 0 {
+		tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		var taggedVpcs []vpcs.Vpc
+		for _, vpc := range refinedVpcs {
+			tags, err := resourceVpcTagsV2Get(tagClient, "vpcs", vpc.ID)
+			if err != nil {
+				return fmt.Errorf("Unable to retrieve tags of vpc %s: %s", vpc.ID, err)
+			}
+			if vpcTagsMatch(tags, wantedTags) {
+				taggedVpcs = append(taggedVpcs, vpc)
+				matchedTags = tags
+			}
+		}
+		refinedVpcs = taggedVpcs
+	}
+
 	if len(refinedVpcs) < 1 {
 		return fmt.Errorf("Your query returned no results. " +
 			"Please change your search criteria and try again.")
@@ -110,5 +135,9 @@ func dataSourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if len(wantedTags) > 0 {
+		d.Set("tags", matchedTags)
+	}
+
 	return nil
 }
@@ -35,6 +35,27 @@ func TestAccOTCVpcV1DataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcV1DataSource_tags(t *testing.T) {
+	rand.Seed(time.Now().UTC().UnixNano())
+	rInt := rand.Intn(50)
+	cidr := fmt.Sprintf("172.16.%d.0/24", rInt)
+	name := fmt.Sprintf("terraform-testacc-vpc-data-source-%d", rInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOTCVpcV1Config_tags(name, cidr, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_tags", name, cidr),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_vpc_v1.by_tags", "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceOTCVpcV1Check(n, name, cidr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -88,3 +109,22 @@ data "opentelekomcloud_vpc_v1" "by_name" {
 }
 `, name, cidr)
 }
+
+func testAccDataSourceOTCVpcV1Config_tags(name, cidr string, rInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+	name = "%s"
+	cidr= "%s"
+
+  tags {
+    testacc = "vpc-data-source-%d"
+  }
+}
+
+data "opentelekomcloud_vpc_v1" "by_tags" {
+  tags {
+    testacc = "${opentelekomcloud_vpc_v1.vpc_1.tags.testacc}"
+  }
+}
+`, name, cidr, rInt)
+}
@@ -0,0 +1,342 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/huaweicloud/golangsdk"
+)
+
+// The Direct Connect API is served from the VPC endpoint under v2.0/dcaas and
+// is not part of golangsdk yet, so the requests are built here against the
+// client returned by hwNetworkV2Client.
+
+// DirectConnect is a physical Direct Connect connection.
+type DirectConnect struct {
+	ID             string `json:"id"`
+	TenantID       string `json:"tenant_id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	PortType       string `json:"port_type"`
+	Bandwidth      int    `json:"bandwidth"`
+	Location       string `json:"location"`
+	PeerLocation   string `json:"peer_location"`
+	DeviceID       string `json:"device_id"`
+	InterfaceName  string `json:"interface_name"`
+	RedundantID    string `json:"redundant_id"`
+	Provider       string `json:"provider"`
+	ProviderStatus string `json:"provider_status"`
+	Type           string `json:"type"`
+	HostingID      string `json:"hosting_id"`
+	VLAN           int    `json:"vlan"`
+	ChargeMode     string `json:"charge_mode"`
+	Status         string `json:"status"`
+	AdminStateUp   bool   `json:"admin_state_up"`
+}
+
+// DCEndpointGroup is a named list of CIDRs used by virtual gateways and
+// virtual interfaces to describe the local and remote subnets.
+type DCEndpointGroup struct {
+	ID          string   `json:"id"`
+	TenantID    string   `json:"tenant_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Endpoints   []string `json:"endpoints"`
+	Type        string   `json:"type"`
+}
+
+// DCEndpointGroupCreateOpts contains the attributes of a new endpoint group.
+type DCEndpointGroupCreateOpts struct {
+	TenantID    string   `json:"tenant_id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Endpoints   []string `json:"endpoints" required:"true"`
+	Type        string   `json:"type" required:"true"`
+}
+
+// VirtualGateway connects a VPC to Direct Connect.
+type VirtualGateway struct {
+	ID             string `json:"id"`
+	TenantID       string `json:"tenant_id"`
+	VpcID          string `json:"vpc_id"`
+	LocalEPGroupID string `json:"local_ep_group_id"`
+	DeviceID       string `json:"device_id"`
+	Type           string `json:"type"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Status         string `json:"status"`
+	AdminStateUp   bool   `json:"admin_state_up"`
+}
+
+// VirtualGatewayCreateOpts contains the attributes of a new virtual gateway.
+type VirtualGatewayCreateOpts struct {
+	TenantID       string `json:"tenant_id,omitempty"`
+	VpcID          string `json:"vpc_id" required:"true"`
+	LocalEPGroupID string `json:"local_ep_group_id" required:"true"`
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+// VirtualGatewayUpdateOpts contains the updatable attributes of a virtual gateway.
+type VirtualGatewayUpdateOpts struct {
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	LocalEPGroupID string `json:"local_ep_group_id,omitempty"`
+}
+
+// VirtualInterface is the logical link between a Direct Connect connection
+// and a virtual gateway.
+type VirtualInterface struct {
+	ID                string `json:"id"`
+	TenantID          string `json:"tenant_id"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	DirectConnectID   string `json:"direct_connect_id"`
+	VgwID             string `json:"vgw_id"`
+	Type              string `json:"type"`
+	ServiceType       string `json:"service_type"`
+	VLAN              int    `json:"vlan"`
+	Bandwidth         int    `json:"bandwidth"`
+	LocalGatewayV4IP  string `json:"local_gateway_v4_ip"`
+	RemoteGatewayV4IP string `json:"remote_gateway_v4_ip"`
+	RouteMode         string `json:"route_mode"`
+	BGPASN            int    `json:"bgp_asn"`
+	BGPMD5            string `json:"bgp_md5"`
+	RemoteEPGroupID   string `json:"remote_ep_group_id"`
+	Status            string `json:"status"`
+	AdminStateUp      bool   `json:"admin_state_up"`
+}
+
+// VirtualInterfaceCreateOpts contains the attributes of a new virtual interface.
+type VirtualInterfaceCreateOpts struct {
+	TenantID          string `json:"tenant_id,omitempty"`
+	Name              string `json:"name,omitempty"`
+	Description       string `json:"description,omitempty"`
+	DirectConnectID   string `json:"direct_connect_id" required:"true"`
+	VgwID             string `json:"vgw_id" required:"true"`
+	Type              string `json:"type" required:"true"`
+	ServiceType       string `json:"service_type" required:"true"`
+	VLAN              int    `json:"vlan" required:"true"`
+	Bandwidth         int    `json:"bandwidth" required:"true"`
+	LocalGatewayV4IP  string `json:"local_gateway_v4_ip" required:"true"`
+	RemoteGatewayV4IP string `json:"remote_gateway_v4_ip" required:"true"`
+	RouteMode         string `json:"route_mode" required:"true"`
+	BGPASN            int    `json:"bgp_asn,omitempty"`
+	BGPMD5            string `json:"bgp_md5,omitempty"`
+	RemoteEPGroupID   string `json:"remote_ep_group_id" required:"true"`
+}
+
+// VirtualInterfaceUpdateOpts contains the updatable attributes of a virtual interface.
+type VirtualInterfaceUpdateOpts struct {
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	Bandwidth       int    `json:"bandwidth,omitempty"`
+	RemoteEPGroupID string `json:"remote_ep_group_id,omitempty"`
+}
+
+func dcV2URL(c *golangsdk.ServiceClient, parts ...string) string {
+	return c.ServiceURL(append([]string{"dcaas"}, parts...)...)
+}
+
+func dcV2ListDirectConnects(c *golangsdk.ServiceClient) ([]DirectConnect, error) {
+	var r struct {
+		DirectConnects []DirectConnect `json:"direct_connects"`
+	}
+	_, err := c.Get(dcV2URL(c, "direct-connects"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.DirectConnects, nil
+}
+
+func dcV2CreateEndpointGroup(c *golangsdk.ServiceClient, opts DCEndpointGroupCreateOpts) (*DCEndpointGroup, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "dc_endpoint_group")
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		EndpointGroup DCEndpointGroup `json:"dc_endpoint_group"`
+	}
+	_, err = c.Post(dcV2URL(c, "dc-endpoint-groups"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &r.EndpointGroup, nil
+}
+
+func dcV2GetEndpointGroup(c *golangsdk.ServiceClient, id string) (*DCEndpointGroup, error) {
+	var r struct {
+		EndpointGroup DCEndpointGroup `json:"dc_endpoint_group"`
+	}
+	_, err := c.Get(dcV2URL(c, "dc-endpoint-groups", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.EndpointGroup, nil
+}
+
+func dcV2DeleteEndpointGroup(c *golangsdk.ServiceClient, id string) error {
+	_, err := c.Delete(dcV2URL(c, "dc-endpoint-groups", id), nil)
+	return err
+}
+
+func dcV2CreateVirtualGateway(c *golangsdk.ServiceClient, opts VirtualGatewayCreateOpts) (*VirtualGateway, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "virtual_gateway")
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		VirtualGateway VirtualGateway `json:"virtual_gateway"`
+	}
+	_, err = c.Post(dcV2URL(c, "virtual-gateways"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &r.VirtualGateway, nil
+}
+
+func dcV2GetVirtualGateway(c *golangsdk.ServiceClient, id string) (*VirtualGateway, error) {
+	var r struct {
+		VirtualGateway VirtualGateway `json:"virtual_gateway"`
+	}
+	_, err := c.Get(dcV2URL(c, "virtual-gateways", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.VirtualGateway, nil
+}
+
+func dcV2UpdateVirtualGateway(c *golangsdk.ServiceClient, id string, opts VirtualGatewayUpdateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "virtual_gateway")
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(dcV2URL(c, "virtual-gateways", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func dcV2DeleteVirtualGateway(c *golangsdk.ServiceClient, id string) error {
+	_, err := c.Delete(dcV2URL(c, "virtual-gateways", id), nil)
+	return err
+}
+
+func dcV2CreateVirtualInterface(c *golangsdk.ServiceClient, opts VirtualInterfaceCreateOpts) (*VirtualInterface, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "virtual_interface")
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		VirtualInterface VirtualInterface `json:"virtual_interface"`
+	}
+	_, err = c.Post(dcV2URL(c, "virtual-interfaces"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &r.VirtualInterface, nil
+}
+
+func dcV2GetVirtualInterface(c *golangsdk.ServiceClient, id string) (*VirtualInterface, error) {
+	var r struct {
+		VirtualInterface VirtualInterface `json:"virtual_interface"`
+	}
+	_, err := c.Get(dcV2URL(c, "virtual-interfaces", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.VirtualInterface, nil
+}
+
+func dcV2UpdateVirtualInterface(c *golangsdk.ServiceClient, id string, opts VirtualInterfaceUpdateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "virtual_interface")
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(dcV2URL(c, "virtual-interfaces", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func dcV2DeleteVirtualInterface(c *golangsdk.ServiceClient, id string) error {
+	_, err := c.Delete(dcV2URL(c, "virtual-interfaces", id), nil)
+	return err
+}
+
+// dcV2CreateCIDREndpointGroup creates the endpoint group that holds the CIDRs
+// of one side of a Direct Connect link.
+func dcV2CreateCIDREndpointGroup(c *golangsdk.ServiceClient, name string, rawCIDRs []interface{}) (string, error) {
+	cidrs := make([]string, len(rawCIDRs))
+	for i, raw := range rawCIDRs {
+		cidrs[i] = raw.(string)
+	}
+
+	group, err := dcV2CreateEndpointGroup(c, DCEndpointGroupCreateOpts{
+		Name:      name,
+		Endpoints: cidrs,
+		Type:      "cidr",
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error creating OpenTelekomCloud Direct Connect endpoint group: %s", err)
+	}
+
+	log.Printf("[DEBUG] Created Direct Connect endpoint group %s: %#v", group.ID, group)
+	return group.ID, nil
+}
+
+func waitForDCV2Status(refresh resource.StateRefreshFunc, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceDCVirtualGatewayV2RefreshFunc(c *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gw, err := dcV2GetVirtualGateway(c, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return gw, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		if gw.Status == "ERROR" {
+			return gw, gw.Status, fmt.Errorf("Virtual gateway %s is in ERROR state", id)
+		}
+		return gw, gw.Status, nil
+	}
+}
+
+func resourceDCVirtualInterfaceV2RefreshFunc(c *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vif, err := dcV2GetVirtualInterface(c, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return vif, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		if vif.Status == "ERROR" {
+			return vif, vif.Status, fmt.Errorf("Virtual interface %s is in ERROR state", id)
+		}
+		return vif, vif.Status, nil
+	}
+}
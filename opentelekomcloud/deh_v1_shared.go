@@ -0,0 +1,128 @@
+package opentelekomcloud
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// golangsdk has no Dedicated Host (DeH) package, so the requests are built
+// here against the client returned by dehV1Client.
+
+// DedicatedHost is a dedicated host as returned by the DeH API.
+type DedicatedHost struct {
+	ID               string                  `json:"dedicated_host_id"`
+	Name             string                  `json:"name"`
+	AutoPlacement    string                  `json:"auto_placement"`
+	AvailabilityZone string                  `json:"availability_zone"`
+	Tenant           string                  `json:"tenant"`
+	HostProperties   DedicatedHostProperties `json:"host_properties"`
+	State            string                  `json:"state"`
+	AvailableVcpus   int                     `json:"available_vcpus"`
+	AvailableMemory  int                     `json:"available_memory"`
+	InstanceTotal    int                     `json:"instance_total"`
+	InstanceUUIDs    []string                `json:"instance_uuids"`
+	AllocatedAt      string                  `json:"allocated_at"`
+}
+
+// DedicatedHostProperties describes the hardware of a dedicated host and the
+// flavors which can be placed on it.
+type DedicatedHostProperties struct {
+	HostType                    string `json:"host_type"`
+	HostTypeName                string `json:"host_type_name"`
+	Vcpus                       int    `json:"vcpus"`
+	Cores                       int    `json:"cores"`
+	Sockets                     int    `json:"sockets"`
+	Memory                      int    `json:"memory"`
+	AvailableInstanceCapacities []struct {
+		Flavor string `json:"flavor"`
+	} `json:"available_instance_capacities"`
+}
+
+// DedicatedHostCreateOpts contains the attributes of new dedicated hosts.
+type DedicatedHostCreateOpts struct {
+	Name             string `json:"name" required:"true"`
+	AvailabilityZone string `json:"availability_zone" required:"true"`
+	HostType         string `json:"host_type" required:"true"`
+	AutoPlacement    string `json:"auto_placement,omitempty"`
+	Quantity         int    `json:"quantity" required:"true"`
+}
+
+// DedicatedHostUpdateOpts contains the updatable attributes of a dedicated
+// host.
+type DedicatedHostUpdateOpts struct {
+	Name          string `json:"name,omitempty"`
+	AutoPlacement string `json:"auto_placement,omitempty"`
+}
+
+// DedicatedHostListOpts filters the dedicated hosts returned by
+// dehV1ListHosts.
+type DedicatedHostListOpts struct {
+	ID               string `q:"dedicated_host_id"`
+	Name             string `q:"name"`
+	HostType         string `q:"host_type"`
+	HostTypeName     string `q:"host_type_name"`
+	Flavor           string `q:"flavor"`
+	State            string `q:"state"`
+	AvailabilityZone string `q:"availability_zone"`
+}
+
+// dehV1AllocateHosts allocates dedicated hosts and returns their IDs.
+func dehV1AllocateHosts(c *golangsdk.ServiceClient, opts DedicatedHostCreateOpts) ([]string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		IDs []string `json:"dedicated_host_ids"`
+	}
+	_, err = c.Post(c.ServiceURL("dedicated-hosts"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.IDs, nil
+}
+
+func dehV1GetHost(c *golangsdk.ServiceClient, id string) (*DedicatedHost, error) {
+	var r struct {
+		Host DedicatedHost `json:"dedicated_host"`
+	}
+	_, err := c.Get(c.ServiceURL("dedicated-hosts", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Host, nil
+}
+
+func dehV1UpdateHost(c *golangsdk.ServiceClient, id string, opts DedicatedHostUpdateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "dedicated_host")
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(c.ServiceURL("dedicated-hosts", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func dehV1ReleaseHost(c *golangsdk.ServiceClient, id string) error {
+	_, err := c.Delete(c.ServiceURL("dedicated-hosts", id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func dehV1ListHosts(c *golangsdk.ServiceClient, opts DedicatedHostListOpts) ([]DedicatedHost, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		Hosts []DedicatedHost `json:"dedicated_hosts"`
+	}
+	_, err = c.Get(c.ServiceURL("dedicated-hosts")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Hosts, nil
+}
@@ -3,8 +3,10 @@ package opentelekomcloud
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/url"
+	"os"
 	"strings"
 
 	//"fmt"
@@ -118,3 +120,15 @@ func suppressAutoscalingGroupAvailabilityZoneDiffs(k, old, new string, d *schema
 
 	return false
 }
+
+// Suppress changes of PEM encoded data which only differ in leading or
+// trailing white space
+func suppressPEMDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+// Suppress the removal of the image cache path of images which were created
+// when it defaulted to ~/.terraform/image_cache and still hold that path
+func suppressImageCachePathDiff(k, old, new string, d *schema.ResourceData) bool {
+	return new == "" && old == fmt.Sprintf("%s/.terraform/image_cache", os.Getenv("HOME"))
+}
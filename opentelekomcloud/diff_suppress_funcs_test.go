@@ -0,0 +1,81 @@
+package opentelekomcloud
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestSuppressPEMDiff(t *testing.T) {
+	certificate := testCertificatePEM(t, time.Now().AddDate(1, 0, 0))
+
+	var testCases = []struct {
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Old:      certificate,
+			New:      certificate,
+			Expected: true,
+		},
+		{
+			Old:      certificate,
+			New:      "\n  " + certificate + "\n\n",
+			Expected: true,
+		},
+		{
+			Old:      certificate,
+			New:      testCertificatePEM(t, time.Now().AddDate(2, 0, 0)),
+			Expected: false,
+		},
+		{
+			Old:      "",
+			New:      certificate,
+			Expected: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		if v := suppressPEMDiff("certificate", tc.Old, tc.New, nil); v != tc.Expected {
+			t.Fatalf("%d: expected %t, got %t", i, tc.Expected, v)
+		}
+	}
+}
+
+func TestSuppressImageCachePathDiff(t *testing.T) {
+	formerDefault := os.Getenv("HOME") + "/.terraform/image_cache"
+
+	var testCases = []struct {
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Old:      formerDefault,
+			New:      "",
+			Expected: true,
+		},
+		{
+			Old:      "/var/cache/images",
+			New:      "",
+			Expected: false,
+		},
+		{
+			Old:      formerDefault,
+			New:      "/var/cache/images",
+			Expected: false,
+		},
+		{
+			Old:      "",
+			New:      formerDefault,
+			Expected: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		if v := suppressImageCachePathDiff("image_cache_path", tc.Old, tc.New, nil); v != tc.Expected {
+			t.Fatalf("%d: expected %t, got %t", i, tc.Expected, v)
+		}
+	}
+}
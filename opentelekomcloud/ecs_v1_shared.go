@@ -0,0 +1,364 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+)
+
+// golangsdk only covers the auto recovery part of the native ECS v1 API, so
+// the cloud server and job requests are built here against the client
+// returned by loadECSV1Client.
+
+// CloudServer is an ECS instance as returned by the ECS v1 API.
+type CloudServer struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Flavor struct {
+		ID string `json:"id"`
+	} `json:"flavor"`
+	Image struct {
+		ID string `json:"id"`
+	} `json:"image"`
+	KeyName          string                          `json:"key_name"`
+	Metadata         map[string]string               `json:"metadata"`
+	AvailabilityZone string                          `json:"OS-EXT-AZ:availability_zone"`
+	Addresses        map[string][]CloudServerAddress `json:"addresses"`
+	SecurityGroups   []CloudServerSecurityGroup      `json:"security_groups"`
+	VolumesAttached  []CloudServerVolumeAttachment   `json:"os-extended-volumes:volumes_attached"`
+}
+
+// CloudServerAddress is an address of an ECS instance, Type is either
+// "fixed" or "floating".
+type CloudServerAddress struct {
+	Addr    string `json:"addr"`
+	Version int    `json:"version"`
+	MacAddr string `json:"OS-EXT-IPS-MAC:mac_addr"`
+	Type    string `json:"OS-EXT-IPS:type"`
+	PortID  string `json:"OS-EXT-IPS:port_id"`
+}
+
+// CloudServerSecurityGroup is a security group an ECS instance belongs to.
+type CloudServerSecurityGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CloudServerVolumeAttachment is a volume attached to an ECS instance.
+type CloudServerVolumeAttachment struct {
+	ID         string `json:"id"`
+	BootIndex  string `json:"bootIndex"`
+	Device     string `json:"device"`
+	DeleteFlag string `json:"delete_on_termination"`
+}
+
+// CloudServerInterface is a NIC of an ECS instance.
+type CloudServerInterface struct {
+	PortID   string `json:"port_id"`
+	NetID    string `json:"net_id"`
+	MacAddr  string `json:"mac_addr"`
+	FixedIPs []struct {
+		SubnetID  string `json:"subnet_id"`
+		IPAddress string `json:"ip_address"`
+	} `json:"fixed_ips"`
+}
+
+// CloudServerCreateOpts contains the attributes of a new ECS instance.
+type CloudServerCreateOpts struct {
+	Name             string                     `json:"name" required:"true"`
+	ImageRef         string                     `json:"imageRef" required:"true"`
+	FlavorRef        string                     `json:"flavorRef" required:"true"`
+	AvailabilityZone string                     `json:"availability_zone" required:"true"`
+	VpcID            string                     `json:"vpcid" required:"true"`
+	Nics             []CloudServerNic           `json:"nics" required:"true"`
+	RootVolume       CloudServerVolume          `json:"root_volume" required:"true"`
+	DataVolumes      []CloudServerVolume        `json:"data_volumes,omitempty"`
+	SecurityGroups   []CloudServerSecurityGroup `json:"security_groups,omitempty"`
+	PublicIP         *CloudServerPublicIP       `json:"publicip,omitempty"`
+	KeyName          string                     `json:"key_name,omitempty"`
+	AdminPass        string                     `json:"adminPass,omitempty"`
+	UserData         []byte                     `json:"user_data,omitempty"`
+	Metadata         map[string]string          `json:"metadata,omitempty"`
+	ServerTags       []VpcTag                   `json:"server_tags,omitempty"`
+	Count            int                        `json:"count,omitempty"`
+}
+
+// CloudServerNic is a NIC of a new ECS instance, IPAddress is chosen from
+// the subnet when empty.
+type CloudServerNic struct {
+	SubnetID  string `json:"subnet_id" required:"true"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// CloudServerVolume is the system or a data disk of a new ECS instance.
+// Encryption is requested through the __system__encrypted and
+// __system__cmkid metadata keys.
+type CloudServerVolume struct {
+	VolumeType string            `json:"volumetype" required:"true"`
+	Size       int               `json:"size,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// CloudServerPublicIP either references an existing EIP by ID or describes
+// an EIP which is allocated together with the instance.
+type CloudServerPublicIP struct {
+	ID  string          `json:"id,omitempty"`
+	EIP *CloudServerEIP `json:"eip,omitempty"`
+}
+
+// CloudServerEIP describes an EIP allocated together with an ECS instance.
+type CloudServerEIP struct {
+	IPType    string               `json:"iptype" required:"true"`
+	BandWidth CloudServerBandWidth `json:"bandwidth" required:"true"`
+}
+
+// CloudServerBandWidth is the bandwidth of an EIP allocated together with an
+// ECS instance.
+type CloudServerBandWidth struct {
+	Size       int    `json:"size,omitempty"`
+	ShareType  string `json:"sharetype" required:"true"`
+	ChargeMode string `json:"chargemode,omitempty"`
+}
+
+// CloudServerDeleteOpts controls what is removed together with ECS instances.
+type CloudServerDeleteOpts struct {
+	Servers        []CloudServerRef `json:"servers" required:"true"`
+	DeletePublicIP bool             `json:"delete_publicip"`
+	DeleteVolume   bool             `json:"delete_volume"`
+}
+
+// CloudServerRef references an ECS instance by ID.
+type CloudServerRef struct {
+	ID string `json:"id" required:"true"`
+}
+
+// CloudServerFlavor is an ECS flavor as returned by the ECS v1 API. VCPUs and
+// Disk are returned as strings.
+type CloudServerFlavor struct {
+	ID         string                      `json:"id"`
+	Name       string                      `json:"name"`
+	VCPUs      string                      `json:"vcpus"`
+	RAM        int                         `json:"ram"`
+	Disk       string                      `json:"disk"`
+	ExtraSpecs CloudServerFlavorExtraSpecs `json:"os_extra_specs"`
+}
+
+// CloudServerFlavorExtraSpecs describes the kind of a flavor and where it is
+// on sale. OperationAZ lists the availability zones together with the status
+// of the flavor in them, e.g. "eu-de-01(normal),eu-de-02(sellout)".
+type CloudServerFlavorExtraSpecs struct {
+	PerformanceType string `json:"ecs:performancetype"`
+	Generation      string `json:"ecs:generation"`
+	OperationStatus string `json:"cond:operation:status"`
+	OperationAZ     string `json:"cond:operation:az"`
+}
+
+// ECSJob is an asynchronous ECS v1 job. Jobs which act on several servers
+// have one sub job per server.
+type ECSJob struct {
+	ID         string `json:"job_id"`
+	Type       string `json:"job_type"`
+	Status     string `json:"status"`
+	ErrorCode  string `json:"error_code"`
+	FailReason string `json:"fail_reason"`
+	Entities   struct {
+		SubJobs []struct {
+			Status   string `json:"status"`
+			Entities struct {
+				ServerID string `json:"server_id"`
+			} `json:"entities"`
+		} `json:"sub_jobs"`
+	} `json:"entities"`
+}
+
+func ecsV1URL(c *golangsdk.ServiceClient, parts ...string) string {
+	return c.ServiceURL(append([]string{"cloudservers"}, parts...)...)
+}
+
+// ecsV1CreateServer starts the creation of an ECS instance and returns the ID
+// of the job doing so.
+func ecsV1CreateServer(c *golangsdk.ServiceClient, opts CloudServerCreateOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "server")
+	if err != nil {
+		return "", err
+	}
+	var r struct {
+		JobID string `json:"job_id"`
+	}
+	_, err = c.Post(ecsV1URL(c), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return r.JobID, nil
+}
+
+func ecsV1GetServer(c *golangsdk.ServiceClient, id string) (*CloudServer, error) {
+	var r struct {
+		Server CloudServer `json:"server"`
+	}
+	_, err := c.Get(ecsV1URL(c, id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Server, nil
+}
+
+func ecsV1UpdateServerName(c *golangsdk.ServiceClient, id, name string) error {
+	b := map[string]interface{}{
+		"server": map[string]interface{}{
+			"name": name,
+		},
+	}
+	_, err := c.Put(ecsV1URL(c, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// ecsV1DeleteServers starts the deletion of ECS instances and returns the ID
+// of the job doing so.
+func ecsV1DeleteServers(c *golangsdk.ServiceClient, opts CloudServerDeleteOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var r struct {
+		JobID string `json:"job_id"`
+	}
+	_, err = c.Post(ecsV1URL(c, "delete"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return r.JobID, nil
+}
+
+func ecsV1ListInterfaces(c *golangsdk.ServiceClient, id string) ([]CloudServerInterface, error) {
+	var r struct {
+		Interfaces []CloudServerInterface `json:"interfaceAttachments"`
+	}
+	_, err := c.Get(ecsV1URL(c, id, "os-interface"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Interfaces, nil
+}
+
+// ecsV1ListFlavors lists the flavors available in availabilityZone, or in
+// any availability zone when it is empty.
+func ecsV1ListFlavors(c *golangsdk.ServiceClient, availabilityZone string) ([]CloudServerFlavor, error) {
+	url := ecsV1URL(c, "flavors")
+	if availabilityZone != "" {
+		url += "?availability_zone=" + availabilityZone
+	}
+	var r struct {
+		Flavors []CloudServerFlavor `json:"flavors"`
+	}
+	_, err := c.Get(url, &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Flavors, nil
+}
+
+func ecsV1GetTags(c *golangsdk.ServiceClient, id string) (map[string]string, error) {
+	var r struct {
+		Tags []VpcTag `json:"tags"`
+	}
+	_, err := c.Get(ecsV1URL(c, id, "tags"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string, len(r.Tags))
+	for _, tag := range r.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	return tags, nil
+}
+
+func ecsV1TagsAction(c *golangsdk.ServiceClient, id, action string, tags []VpcTag) error {
+	b := map[string]interface{}{
+		"action": action,
+		"tags":   tags,
+	}
+	_, err := c.Post(ecsV1URL(c, id, "tags", "action"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+// ecsV1UpdateTags applies the changes of the "tags" attribute of d to the ECS
+// instance id.
+func ecsV1UpdateTags(c *golangsdk.ServiceClient, d *schema.ResourceData, id string) error {
+	if !d.HasChange("tags") {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange("tags")
+	remove, create := diffVpcTags(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags %#v from ECS instance %s", remove, id)
+		if err := ecsV1TagsAction(c, id, "delete", remove); err != nil {
+			return fmt.Errorf("Error removing tags from ECS instance %s: %s", id, err)
+		}
+	}
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags %#v on ECS instance %s", create, id)
+		if err := ecsV1TagsAction(c, id, "create", create); err != nil {
+			return fmt.Errorf("Error creating tags on ECS instance %s: %s", id, err)
+		}
+	}
+
+	return nil
+}
+
+func ecsV1GetJob(c *golangsdk.ServiceClient, id string) (*ECSJob, error) {
+	var job ECSJob
+	_, err := c.Get(c.ServiceURL("jobs", id), &job, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// waitForECSV1Job polls the ECS job id until it succeeds or fails.
+func waitForECSV1Job(c *golangsdk.ServiceClient, id string, timeout time.Duration) (*ECSJob, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"INIT", "RUNNING"},
+		Target:     []string{"SUCCESS"},
+		Refresh:    ecsV1JobRefreshFunc(c, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	job, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	return job.(*ECSJob), nil
+}
+
+func ecsV1JobRefreshFunc(c *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := ecsV1GetJob(c, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if job.Status == "FAIL" {
+			return job, job.Status, fmt.Errorf("ECS job %s failed: %s (%s)", id, job.FailReason, job.ErrorCode)
+		}
+		return job, job.Status, nil
+	}
+}
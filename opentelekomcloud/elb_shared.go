@@ -0,0 +1,122 @@
+package opentelekomcloud
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/elbaas/backendmember"
+)
+
+// golangsdk has no certificate package for the classic ELB API, so the
+// requests are built here against the client returned by loadELBClient.
+
+func elbCertificateURL(c *golangsdk.ServiceClient, parts ...string) string {
+	return c.ServiceURL(append([]string{"elbaas", "certificate"}, parts...)...)
+}
+
+func elbCreateCertificate(c *golangsdk.ServiceClient, opts CertificateOpts) (*Certificate, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	var cert Certificate
+	_, err = c.Post(elbCertificateURL(c), b, &cert, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// elbGetCertificate looks up the certificate id. The classic ELB API only
+// lists certificates, a missing one is reported as golangsdk.ErrDefault404.
+func elbGetCertificate(c *golangsdk.ServiceClient, id string) (*Certificate, error) {
+	var r struct {
+		Certificates []Certificate `json:"certificates"`
+	}
+	_, err := c.Get(elbCertificateURL(c), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range r.Certificates {
+		if cert.ID == id {
+			return &cert, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func elbUpdateCertificate(c *golangsdk.ServiceClient, id string, opts CertificateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(elbCertificateURL(c, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func elbDeleteCertificate(c *golangsdk.ServiceClient, id string) error {
+	_, err := c.Delete(elbCertificateURL(c, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+// The backendmember package of golangsdk adds and removes a single backend
+// per job, while the API accepts a list. The batched requests are built here.
+
+func elbBackendsURL(c *golangsdk.ServiceClient, listenerID string, parts ...string) string {
+	return c.ServiceURL(append([]string{"elbaas", "listeners", listenerID, "members"}, parts...)...)
+}
+
+// elbListBackends returns all backends of a classic ELB listener.
+func elbListBackends(c *golangsdk.ServiceClient, listenerID string) ([]backendmember.Backend, error) {
+	var backends []backendmember.Backend
+	_, err := c.Get(elbBackendsURL(c, listenerID), &backends, nil)
+	if err != nil {
+		return nil, err
+	}
+	return backends, nil
+}
+
+// elbAddBackends adds the backends opts to a listener in a single job.
+func elbAddBackends(c *golangsdk.ServiceClient, listenerID string, opts []backendmember.AddOpts) (*golangsdk.JobResponse, error) {
+	b := make([]map[string]interface{}, len(opts))
+	for i, o := range opts {
+		m, err := o.ToBackendAddMap()
+		if err != nil {
+			return nil, err
+		}
+		b[i] = m
+	}
+	var job golangsdk.JobResponse
+	_, err := c.Post(elbBackendsURL(c, listenerID), b, &job, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// elbRemoveBackends removes the backends with the given IDs from a listener
+// in a single job.
+func elbRemoveBackends(c *golangsdk.ServiceClient, listenerID string, ids []string) (*golangsdk.JobResponse, error) {
+	opts := backendmember.RemoveOpts{}
+	for _, id := range ids {
+		opts.RemoveMember = append(opts.RemoveMember, backendmember.LoadBalancerID{ID: id})
+	}
+	b, err := opts.ToBackendRemoveMap()
+	if err != nil {
+		return nil, err
+	}
+	var job golangsdk.JobResponse
+	_, err = c.Post(elbBackendsURL(c, listenerID, "action"), b, &job, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
@@ -0,0 +1,234 @@
+package opentelekomcloud
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// imagesImageV2ObsPartSize is the size of the parts an image is split into
+// when it is uploaded to OBS.
+const imagesImageV2ObsPartSize = 64 * 1024 * 1024
+
+// resourceImagesImageV2Source opens the image data of d. Local files and
+// images kept in the image cache are returned as *os.File, images from
+// image_source_url are streamed from the response body otherwise. The size is
+// -1 when it is not known in advance.
+func resourceImagesImageV2Source(d *schema.ResourceData) (io.ReadCloser, int64, error) {
+	furl := d.Get("image_source_url").(string)
+	if furl == "" || d.Get("image_cache_path").(string) != "" {
+		filename, err := resourceImagesImageV2File(d)
+		if err != nil {
+			return nil, -1, err
+		}
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, -1, fmt.Errorf("Error opening file %q: %s", filename, err)
+		}
+		fstat, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return nil, -1, fmt.Errorf("Error reading image file %q: %s", filename, err)
+		}
+		return file, fstat.Size(), nil
+	}
+
+	log.Printf("[DEBUG] Streaming image from %s", furl)
+	resp, err := http.Get(furl)
+	if err != nil {
+		return nil, -1, fmt.Errorf("Error downloading image from %q: %s", furl, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, -1, fmt.Errorf("Error downloading image from %q: %s", furl, resp.Status)
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// resourceImagesImageV2VerifyChecksum compares the MD5 checksum of the
+// uploaded data with verify_checksum, if set.
+func resourceImagesImageV2VerifyChecksum(d *schema.ResourceData, checksum string) error {
+	want := strings.ToLower(d.Get("verify_checksum").(string))
+	if want == "" || want == checksum {
+		return nil
+	}
+	return fmt.Errorf("Image checksum mismatch: expected %s, got %s", want, checksum)
+}
+
+// resourceImagesImageV2ObsKey returns the OBS object key the image is staged
+// as, which defaults to the file name of the image source.
+func resourceImagesImageV2ObsKey(d *schema.ResourceData) (string, error) {
+	if key := d.Get("obs_key").(string); key != "" {
+		return key, nil
+	}
+	if filename := d.Get("local_file_path").(string); filename != "" {
+		return path.Base(filename), nil
+	}
+	u, err := url.Parse(d.Get("image_source_url").(string))
+	if err != nil {
+		return "", fmt.Errorf("Error parsing image_source_url: %s", err)
+	}
+	if key := path.Base(u.Path); key != "/" && key != "." {
+		return key, nil
+	}
+	return "", fmt.Errorf("Unable to determine the OBS object key from %q, obs_key must be set", u.String())
+}
+
+// imagesImageV2ObsUpload uploads r to bucket/key as a multipart upload. When
+// resume is set, an unfinished upload of the key is continued and parts whose
+// MD5 matches the data already stored in OBS are skipped, so a failed upload
+// of a large file can be resumed by applying again. Unfinished uploads are
+// kept for that purpose, otherwise they are aborted on failure.
+func imagesImageV2ObsUpload(conn *s3.S3, bucket, key string, r io.Reader, resume bool) error {
+	var uploadID string
+	uploaded := make(map[int64]string)
+
+	if resume {
+		var err error
+		uploadID, uploaded, err = imagesImageV2ObsFindUpload(conn, bucket, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	if uploadID == "" {
+		out, err := conn.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("Error starting upload to %s/%s: %s", bucket, key, err)
+		}
+		uploadID = *out.UploadId
+	} else {
+		log.Printf("[DEBUG] Resuming upload %s to %s/%s, %d parts already uploaded", uploadID, bucket, key, len(uploaded))
+	}
+
+	parts, err := imagesImageV2ObsUploadParts(conn, bucket, key, uploadID, uploaded, r)
+	if err != nil {
+		if resume {
+			log.Printf("[WARN] Upload %s to %s/%s is kept to be resumed", uploadID, bucket, key)
+		} else {
+			conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(bucket),
+				Key:      aws.String(key),
+				UploadId: aws.String(uploadID),
+			})
+		}
+		return err
+	}
+
+	_, err = conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		return fmt.Errorf("Error completing upload to %s/%s: %s", bucket, key, err)
+	}
+
+	return nil
+}
+
+func imagesImageV2ObsUploadParts(conn *s3.S3, bucket, key, uploadID string, uploaded map[int64]string, r io.Reader) ([]*s3.CompletedPart, error) {
+	var parts []*s3.CompletedPart
+	buf := make([]byte, imagesImageV2ObsPartSize)
+
+	for partNumber := int64(1); ; partNumber++ {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("Error reading image data: %s", err)
+		}
+
+		data := buf[:n]
+		sum := md5.Sum(data)
+		etag := hex.EncodeToString(sum[:])
+
+		if uploaded[partNumber] == etag {
+			log.Printf("[DEBUG] Part %d of %s/%s is already uploaded", partNumber, bucket, key)
+		} else {
+			log.Printf("[DEBUG] Uploading part %d (%d bytes) of %s/%s", partNumber, n, bucket, key)
+			_, err := conn.UploadPart(&s3.UploadPartInput{
+				Bucket:     aws.String(bucket),
+				Key:        aws.String(key),
+				UploadId:   aws.String(uploadID),
+				PartNumber: aws.Int64(partNumber),
+				Body:       bytes.NewReader(data),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("Error uploading part %d to %s/%s: %s", partNumber, bucket, key, err)
+			}
+		}
+
+		parts = append(parts, &s3.CompletedPart{
+			ETag:       aws.String(fmt.Sprintf("%q", etag)),
+			PartNumber: aws.Int64(partNumber),
+		})
+
+		if n < len(buf) {
+			break
+		}
+	}
+
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("Image data is empty")
+	}
+	return parts, nil
+}
+
+// imagesImageV2ObsFindUpload looks for an unfinished multipart upload of
+// bucket/key and returns its ID together with the ETags of the parts
+// uploaded so far.
+func imagesImageV2ObsFindUpload(conn *s3.S3, bucket, key string) (string, map[int64]string, error) {
+	uploaded := make(map[int64]string)
+
+	out, err := conn.ListMultipartUploads(&s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(key),
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("Error listing uploads of %s/%s: %s", bucket, key, err)
+	}
+
+	var uploadID string
+	for _, upload := range out.Uploads {
+		if *upload.Key == key {
+			uploadID = *upload.UploadId
+		}
+	}
+	if uploadID == "" {
+		return "", uploaded, nil
+	}
+
+	err = conn.ListPartsPages(&s3.ListPartsInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	}, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			uploaded[*part.PartNumber] = strings.Trim(*part.ETag, `"`)
+		}
+		return true
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("Error listing parts of upload %s: %s", uploadID, err)
+	}
+
+	return uploadID, uploaded, nil
+}
@@ -0,0 +1,33 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2BMSServer_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_compute_bms_server_v2.server_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBMS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2BMSServerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2BMSServer_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"image_name",
+					"metadata",
+					"network",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDCVirtualGatewayV2_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_dc_virtual_gateway_v2.vgw_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDC(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDCVirtualGatewayV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDCVirtualGatewayV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
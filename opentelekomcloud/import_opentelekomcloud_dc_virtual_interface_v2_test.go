@@ -0,0 +1,31 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDCVirtualInterfaceV2_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_dc_virtual_interface_v2.vif_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDC(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDCVirtualInterfaceV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDCVirtualInterfaceV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"bgp_md5",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDehHostV1_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_deh_host_v1.deh_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDeh(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDehHostV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDehHostV1_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
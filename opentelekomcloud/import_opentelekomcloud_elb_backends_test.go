@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccELBBackends_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_elb_backends.backends_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckELBBackendsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccELBBackendsConfig_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccImagesImageAccessV2_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_images_image_access_v2.access_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckImageShare(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageAccessV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageAccessV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"region",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLBV2L7Policy_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_l7policy_v2.l7policy_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2L7PolicyConfig_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVpnEndpointGroupV2_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_vpnaas_endpoint_group_v2.group_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnEndpointGroupV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnEndpointGroupV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVpnIPSecPolicyV2_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnIPSecPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnIPSecPolicyV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVpnSiteConnectionV2_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_vpnaas_site_connection_v2.conn_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnSiteConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnSiteConnectionV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
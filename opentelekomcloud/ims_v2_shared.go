@@ -0,0 +1,273 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+)
+
+// The IMS specific image creation requests are not part of golangsdk, so they
+// are built here against the client returned by imsClient. Created images are
+// regular Glance images, so reading, renaming and deleting them goes through
+// imageV2Client.
+
+// ImsImageCreateOpts contains the attributes of a new private image, created
+// from an ECS instance or an image file in OBS.
+type ImsImageCreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	InstanceID  string `json:"instance_id,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	MinDisk     int    `json:"min_disk,omitempty"`
+	OsVersion   string `json:"os_version,omitempty"`
+	IsConfig    bool   `json:"is_config,omitempty"`
+	CmkID       string `json:"cmk_id,omitempty"`
+	MinRAM      int    `json:"min_ram,omitempty"`
+	MaxRAM      int    `json:"max_ram,omitempty"`
+}
+
+// ImsDataImageCreateOpts contains the attributes of a new data disk image,
+// created from a volume attached to an ECS instance.
+type ImsDataImageCreateOpts struct {
+	Name        string `json:"name" required:"true"`
+	Description string `json:"description,omitempty"`
+	VolumeID    string `json:"volume_id" required:"true"`
+}
+
+// ImsJob is an asynchronous IMS job.
+type ImsJob struct {
+	ID         string `json:"job_id"`
+	Type       string `json:"job_type"`
+	Status     string `json:"status"`
+	ErrorCode  string `json:"error_code"`
+	FailReason string `json:"fail_reason"`
+	Entities   struct {
+		ImageID string `json:"image_id"`
+	} `json:"entities"`
+}
+
+// imsV2CreateImage starts the creation of an image and returns the ID of the
+// job doing so.
+func imsV2CreateImage(c *golangsdk.ServiceClient, opts ImsImageCreateOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var r struct {
+		JobID string `json:"job_id"`
+	}
+	_, err = c.Post(c.ServiceURL("v2", "cloudimages", "action"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return r.JobID, nil
+}
+
+// imsV1CreateDataImage starts the creation of a data disk image and returns
+// the ID of the job doing so.
+func imsV1CreateDataImage(c *golangsdk.ServiceClient, opts ImsDataImageCreateOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var r struct {
+		JobID string `json:"job_id"`
+	}
+	_, err = c.Post(c.ServiceURL("v1", "cloudimages", "dataimages", "action"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return r.JobID, nil
+}
+
+func imsV1GetJob(c *golangsdk.ServiceClient, id string) (*ImsJob, error) {
+	var job ImsJob
+	_, err := c.Get(c.ServiceURL("v1", c.ProjectID, "jobs", id), &job, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// waitForImsJob polls the IMS job id until it succeeds or fails and returns
+// the ID of the created image.
+func waitForImsJob(c *golangsdk.ServiceClient, id string, timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"INIT", "RUNNING"},
+		Target:  []string{"SUCCESS"},
+		Refresh: func() (interface{}, string, error) {
+			job, err := imsV1GetJob(c, id)
+			if err != nil {
+				return nil, "", err
+			}
+			if job.Status == "FAIL" {
+				return job, job.Status, fmt.Errorf("IMS job %s failed: %s (%s)", id, job.FailReason, job.ErrorCode)
+			}
+			return job, job.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	job, err := stateConf.WaitForState()
+	if err != nil {
+		return "", err
+	}
+	imageID := job.(*ImsJob).Entities.ImageID
+	if imageID == "" {
+		return "", fmt.Errorf("IMS job %s did not return the ID of the created image", id)
+	}
+	return imageID, nil
+}
+
+// resourceImsImageV2Create runs the IMS request and stores the ID of the
+// created image in d.
+func resourceImsImageV2Create(d *schema.ResourceData, config *Config, opts ImsImageCreateOpts) error {
+	imsClient, err := config.imsClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud IMS client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	jobID, err := imsV2CreateImage(imsClient, opts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image: %s", err)
+	}
+
+	return resourceImsImageV2WaitForJob(d, imsClient, jobID)
+}
+
+// resourceImsDataImageV2Create runs the IMS request creating a data disk
+// image and stores the ID of the created image in d.
+func resourceImsDataImageV2Create(d *schema.ResourceData, config *Config, opts ImsDataImageCreateOpts) error {
+	imsClient, err := config.imsClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud IMS client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	jobID, err := imsV1CreateDataImage(imsClient, opts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud data image: %s", err)
+	}
+
+	return resourceImsImageV2WaitForJob(d, imsClient, jobID)
+}
+
+// resourceImsImageV2WaitForJob waits for the IMS job creating an image and
+// stores the ID of the created image in d.
+func resourceImsImageV2WaitForJob(d *schema.ResourceData, imsClient *golangsdk.ServiceClient, jobID string) error {
+	log.Printf("[DEBUG] Waiting for IMS job (%s) to create the image", jobID)
+	imageID, err := waitForImsJob(imsClient, jobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for image to be created: %s", err)
+	}
+
+	d.SetId(imageID)
+	log.Printf("[INFO] Image ID: %s", imageID)
+	return nil
+}
+
+func resourceImsImageV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	img, err := images.Get(imageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "image")
+	}
+
+	log.Printf("[DEBUG] Retrieved Image %s: %#v", d.Id(), img)
+
+	d.Set("name", img.Name)
+	d.Set("status", img.Status)
+	d.Set("visibility", img.Visibility)
+	d.Set("disk_format", img.DiskFormat)
+	d.Set("min_disk_gb", img.MinDiskGigabytes)
+	d.Set("size_bytes", img.SizeBytes)
+	d.Set("checksum", img.Checksum)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImsImageV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		updateOpts := images.UpdateOpts{
+			images.ReplaceImageName{NewName: d.Get("name").(string)},
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		_, err = images.Update(imageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating image: %s", err)
+		}
+	}
+
+	return resourceImsImageV2Read(d, meta)
+}
+
+func resourceImsImageV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Image %s", d.Id())
+	if err := images.Delete(imageClient, d.Id()).Err; err != nil {
+		return CheckDeleted(d, err, "image")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceImsImageV2ComputedSchema adds the attributes read from Glance to
+// the schema of an IMS image resource.
+func resourceImsImageV2ComputedSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["status"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["visibility"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["disk_format"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["min_disk_gb"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	s["size_bytes"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	s["checksum"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return s
+}
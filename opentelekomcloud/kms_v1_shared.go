@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// golangsdk only covers the key management part of the KMS API, so the data
+// encryption request is built here against the client returned by
+// kmsKeyV1Client.
+
+// kmsV1EncryptData encrypts up to 4096 bytes of plainText with the KMS key
+// keyID and returns the base64 encoded cipher text.
+func kmsV1EncryptData(c *golangsdk.ServiceClient, keyID, plainText string) (string, error) {
+	b := map[string]interface{}{
+		"key_id":     keyID,
+		"plain_text": plainText,
+	}
+	var r struct {
+		CipherText string `json:"cipher_text"`
+	}
+	_, err := c.Post(c.ServiceURL(c.ProjectID, "kms", "encrypt-data"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return r.CipherText, nil
+}
@@ -3,6 +3,7 @@ package opentelekomcloud
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -214,15 +215,23 @@ func resourceLBV2PoolRefreshFunc(networkingClient *gophercloud.ServiceClient, po
 }
 
 func waitForLBV2viaPool(networkingClient *gophercloud.ServiceClient, id string, target string, timeout time.Duration) error {
-	pool, err := pools.Get(networkingClient, id).Extract()
+	lbID, err := lbV2LoadBalancerIDviaPool(networkingClient, id)
 	if err != nil {
 		return err
 	}
 
+	return waitForLBV2LoadBalancer(networkingClient, lbID, target, nil, timeout)
+}
+
+func lbV2LoadBalancerIDviaPool(networkingClient *gophercloud.ServiceClient, id string) (string, error) {
+	pool, err := pools.Get(networkingClient, id).Extract()
+	if err != nil {
+		return "", err
+	}
+
 	if pool.Loadbalancers != nil {
 		// each pool has an LB in Octavia lbaasv2 API
-		lbID := pool.Loadbalancers[0].ID
-		return waitForLBV2LoadBalancer(networkingClient, lbID, target, nil, timeout)
+		return pool.Loadbalancers[0].ID, nil
 	}
 
 	if pool.Listeners != nil {
@@ -230,14 +239,511 @@ func waitForLBV2viaPool(networkingClient *gophercloud.ServiceClient, id string,
 		listenerID := pool.Listeners[0].ID
 		listener, err := listeners.Get(networkingClient, listenerID).Extract()
 		if err != nil {
-			return err
+			return "", err
 		}
 		if listener.Loadbalancers != nil {
-			lbID := listener.Loadbalancers[0].ID
-			return waitForLBV2LoadBalancer(networkingClient, lbID, target, nil, timeout)
+			return listener.Loadbalancers[0].ID, nil
 		}
 	}
 
 	// got a pool but no LB - this is wrong
-	return fmt.Errorf("No Load Balancer on pool %s", id)
+	return "", fmt.Errorf("No Load Balancer on pool %s", id)
+}
+
+func waitForLBV2viaListener(networkingClient *gophercloud.ServiceClient, id string, target string, timeout time.Duration) error {
+	listener, err := listeners.Get(networkingClient, id).Extract()
+	if err != nil {
+		return err
+	}
+
+	if listener.Loadbalancers != nil {
+		lbID := listener.Loadbalancers[0].ID
+		return waitForLBV2LoadBalancer(networkingClient, lbID, target, nil, timeout)
+	}
+
+	// got a listener but no LB - this is wrong
+	return fmt.Errorf("No Load Balancer on listener %s", id)
+}
+
+func waitForLBV2L7Policy(networkingClient *gophercloud.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for l7policy %s to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceLBV2L7PolicyRefreshFunc(networkingClient, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			switch target {
+			case "DELETED":
+				return nil
+			default:
+				return fmt.Errorf("Error: l7policy %s not found: %s", id, err)
+			}
+		}
+		return fmt.Errorf("Error waiting for l7policy %s to become %s: %s", id, target, err)
+	}
+
+	return nil
+}
+
+func resourceLBV2L7PolicyRefreshFunc(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		l7policy, err := lbV2GetL7Policy(networkingClient, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return l7policy, l7policy.ProvisioningStatus, nil
+	}
+}
+
+// gophercloud has no l7policies package at the vendored revision, so the
+// requests for layer-7 policies and their rules are built here. Besides the
+// pool redirect the enhanced load balancer supports REDIRECT_TO_LISTENER with
+// the redirect_listener_id attribute.
+
+// LBL7PolicyV2 is a layer-7 policy of a load balancer v2 listener.
+type LBL7PolicyV2 struct {
+	ID                 string       `json:"id"`
+	TenantID           string       `json:"tenant_id"`
+	Name               string       `json:"name"`
+	Description        string       `json:"description"`
+	ListenerID         string       `json:"listener_id"`
+	Action             string       `json:"action"`
+	Position           int32        `json:"position"`
+	RedirectPoolID     string       `json:"redirect_pool_id"`
+	RedirectListenerID string       `json:"redirect_listener_id"`
+	AdminStateUp       bool         `json:"admin_state_up"`
+	ProvisioningStatus string       `json:"provisioning_status"`
+	Rules              []LBL7RuleV2 `json:"rules"`
+}
+
+// LBL7PolicyV2CreateOpts contains the attributes of a new layer-7 policy.
+type LBL7PolicyV2CreateOpts struct {
+	TenantID           string `json:"tenant_id,omitempty"`
+	Name               string `json:"name,omitempty"`
+	Description        string `json:"description,omitempty"`
+	ListenerID         string `json:"listener_id" required:"true"`
+	Action             string `json:"action" required:"true"`
+	Position           int32  `json:"position,omitempty"`
+	RedirectPoolID     string `json:"redirect_pool_id,omitempty"`
+	RedirectListenerID string `json:"redirect_listener_id,omitempty"`
+	AdminStateUp       *bool  `json:"admin_state_up,omitempty"`
+}
+
+// LBL7PolicyV2UpdateOpts contains the changed attributes of a layer-7 policy.
+// An empty redirect target is sent as null to unset it.
+type LBL7PolicyV2UpdateOpts struct {
+	Name               *string `json:"name,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	Position           int32   `json:"position,omitempty"`
+	RedirectPoolID     *string `json:"redirect_pool_id,omitempty"`
+	RedirectListenerID *string `json:"redirect_listener_id,omitempty"`
+	AdminStateUp       *bool   `json:"admin_state_up,omitempty"`
+}
+
+// LBL7RuleV2 is a rule of a layer-7 policy.
+type LBL7RuleV2 struct {
+	ID           string `json:"id"`
+	TenantID     string `json:"tenant_id"`
+	RuleType     string `json:"type"`
+	CompareType  string `json:"compare_type"`
+	Value        string `json:"value"`
+	AdminStateUp bool   `json:"admin_state_up"`
+}
+
+// LBL7RuleV2Opts contains the attributes of a new or an updated rule.
+type LBL7RuleV2Opts struct {
+	TenantID     string `json:"tenant_id,omitempty"`
+	RuleType     string `json:"type,omitempty"`
+	CompareType  string `json:"compare_type,omitempty"`
+	Value        string `json:"value,omitempty"`
+	AdminStateUp *bool  `json:"admin_state_up,omitempty"`
+}
+
+func lbV2L7PolicyURL(c *gophercloud.ServiceClient, parts ...string) string {
+	return c.ServiceURL(append([]string{"lbaas", "l7policies"}, parts...)...)
+}
+
+func lbV2CreateL7Policy(c *gophercloud.ServiceClient, opts LBL7PolicyV2CreateOpts) (*LBL7PolicyV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "l7policy")
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		L7Policy LBL7PolicyV2 `json:"l7policy"`
+	}
+	_, err = c.Post(lbV2L7PolicyURL(c), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &r.L7Policy, nil
+}
+
+func lbV2GetL7Policy(c *gophercloud.ServiceClient, id string) (*LBL7PolicyV2, error) {
+	var r struct {
+		L7Policy LBL7PolicyV2 `json:"l7policy"`
+	}
+	_, err := c.Get(lbV2L7PolicyURL(c, id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.L7Policy, nil
+}
+
+func lbV2UpdateL7Policy(c *gophercloud.ServiceClient, id string, opts LBL7PolicyV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "l7policy")
+	if err != nil {
+		return err
+	}
+	m := b["l7policy"].(map[string]interface{})
+	for _, k := range []string{"redirect_pool_id", "redirect_listener_id"} {
+		if m[k] == "" {
+			m[k] = nil
+		}
+	}
+	_, err = c.Put(lbV2L7PolicyURL(c, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func lbV2DeleteL7Policy(c *gophercloud.ServiceClient, id string) error {
+	_, err := c.Delete(lbV2L7PolicyURL(c, id), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func lbV2L7RuleURL(c *gophercloud.ServiceClient, policyID string, parts ...string) string {
+	return c.ServiceURL(append([]string{"lbaas", "l7policies", policyID, "rules"}, parts...)...)
+}
+
+func lbV2CreateL7Rule(c *gophercloud.ServiceClient, policyID string, opts LBL7RuleV2Opts) (*LBL7RuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "rule")
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		Rule LBL7RuleV2 `json:"rule"`
+	}
+	_, err = c.Post(lbV2L7RuleURL(c, policyID), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &r.Rule, nil
+}
+
+func lbV2GetL7Rule(c *gophercloud.ServiceClient, policyID, id string) (*LBL7RuleV2, error) {
+	var r struct {
+		Rule LBL7RuleV2 `json:"rule"`
+	}
+	_, err := c.Get(lbV2L7RuleURL(c, policyID, id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Rule, nil
+}
+
+func lbV2UpdateL7Rule(c *gophercloud.ServiceClient, policyID, id string, opts LBL7RuleV2Opts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "rule")
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(lbV2L7RuleURL(c, policyID, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return err
+}
+
+func lbV2DeleteL7Rule(c *gophercloud.ServiceClient, policyID, id string) error {
+	_, err := c.Delete(lbV2L7RuleURL(c, policyID, id), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// gophercloud has no certificate package for the load balancer v2 API, so the
+// certificate requests are built here against the networking client.
+
+func lbV2CertificateURL(c *gophercloud.ServiceClient, parts ...string) string {
+	return c.ServiceURL(append([]string{"lbaas", "certificates"}, parts...)...)
+}
+
+func lbV2CreateCertificate(c *gophercloud.ServiceClient, opts CertificateOpts) (*Certificate, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	var cert Certificate
+	_, err = c.Post(lbV2CertificateURL(c), b, &cert, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+func lbV2GetCertificate(c *gophercloud.ServiceClient, id string) (*Certificate, error) {
+	var cert Certificate
+	_, err := c.Get(lbV2CertificateURL(c, id), &cert, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+func lbV2UpdateCertificate(c *gophercloud.ServiceClient, id string, opts CertificateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(lbV2CertificateURL(c, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func lbV2DeleteCertificate(c *gophercloud.ServiceClient, id string) error {
+	_, err := c.Delete(lbV2CertificateURL(c, id), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// gophercloud has no whitelist package either, the whitelist of a listener is
+// managed with the requests below.
+
+// LBWhitelistV2 is the access control list of a load balancer v2 listener.
+// Whitelist holds the allowed addresses separated by commas.
+type LBWhitelistV2 struct {
+	ID              string `json:"id"`
+	TenantID        string `json:"tenant_id"`
+	ListenerID      string `json:"listener_id"`
+	EnableWhitelist bool   `json:"enable_whitelist"`
+	Whitelist       string `json:"whitelist"`
+}
+
+// LBWhitelistV2Opts contains the attributes of a new or an updated whitelist.
+type LBWhitelistV2Opts struct {
+	TenantID        string  `json:"tenant_id,omitempty"`
+	ListenerID      string  `json:"listener_id,omitempty"`
+	EnableWhitelist *bool   `json:"enable_whitelist,omitempty"`
+	Whitelist       *string `json:"whitelist,omitempty"`
+}
+
+func lbV2WhitelistURL(c *gophercloud.ServiceClient, parts ...string) string {
+	return c.ServiceURL(append([]string{"lbaas", "whitelists"}, parts...)...)
+}
+
+func lbV2CreateWhitelist(c *gophercloud.ServiceClient, opts LBWhitelistV2Opts) (*LBWhitelistV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "whitelist")
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		Whitelist LBWhitelistV2 `json:"whitelist"`
+	}
+	_, err = c.Post(lbV2WhitelistURL(c), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &r.Whitelist, nil
+}
+
+func lbV2GetWhitelist(c *gophercloud.ServiceClient, id string) (*LBWhitelistV2, error) {
+	var r struct {
+		Whitelist LBWhitelistV2 `json:"whitelist"`
+	}
+	_, err := c.Get(lbV2WhitelistURL(c, id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Whitelist, nil
+}
+
+// lbV2ListWhitelists returns the whitelists of the listener with the given
+// ID, a listener has at most one.
+func lbV2ListWhitelists(c *gophercloud.ServiceClient, listenerID string) ([]LBWhitelistV2, error) {
+	var r struct {
+		Whitelists []LBWhitelistV2 `json:"whitelists"`
+	}
+	_, err := c.Get(lbV2WhitelistURL(c)+"?listener_id="+url.QueryEscape(listenerID), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Whitelists, nil
+}
+
+func lbV2UpdateWhitelist(c *gophercloud.ServiceClient, id string, opts LBWhitelistV2Opts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "whitelist")
+	if err != nil {
+		return err
+	}
+	_, err = c.Put(lbV2WhitelistURL(c, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func lbV2DeleteWhitelist(c *gophercloud.ServiceClient, id string) error {
+	_, err := c.Delete(lbV2WhitelistURL(c, id), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// The status tree of gophercloud lacks the operating status of listeners,
+// pools and members, so it is read with the types below.
+
+// LBStatusTreeV2 is the status tree of a load balancer.
+type LBStatusTreeV2 struct {
+	ID                 string               `json:"id"`
+	Name               string               `json:"name"`
+	OperatingStatus    string               `json:"operating_status"`
+	ProvisioningStatus string               `json:"provisioning_status"`
+	Listeners          []LBListenerStatusV2 `json:"listeners"`
+	Pools              []LBPoolStatusV2     `json:"pools"`
+}
+
+// LBListenerStatusV2 is the status of a listener within a status tree.
+type LBListenerStatusV2 struct {
+	ID                 string           `json:"id"`
+	Name               string           `json:"name"`
+	OperatingStatus    string           `json:"operating_status"`
+	ProvisioningStatus string           `json:"provisioning_status"`
+	Pools              []LBPoolStatusV2 `json:"pools"`
+}
+
+// LBPoolStatusV2 is the status of a pool within a status tree.
+type LBPoolStatusV2 struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	OperatingStatus    string `json:"operating_status"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	HealthMonitor      struct {
+		ID                 string `json:"id"`
+		Type               string `json:"type"`
+		ProvisioningStatus string `json:"provisioning_status"`
+	} `json:"healthmonitor"`
+	Members []LBMemberStatusV2 `json:"members"`
+}
+
+// LBMemberStatusV2 is the status of a member within a status tree.
+type LBMemberStatusV2 struct {
+	ID                 string `json:"id"`
+	Address            string `json:"address"`
+	ProtocolPort       int    `json:"protocol_port"`
+	OperatingStatus    string `json:"operating_status"`
+	ProvisioningStatus string `json:"provisioning_status"`
+}
+
+func lbV2GetStatusTree(c *gophercloud.ServiceClient, id string) (*LBStatusTreeV2, error) {
+	var r struct {
+		Statuses struct {
+			LoadBalancer LBStatusTreeV2 `json:"loadbalancer"`
+		} `json:"statuses"`
+	}
+	_, err := c.Get(c.ServiceURL("lbaas", "loadbalancers", id, "statuses"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Statuses.LoadBalancer, nil
+}
+
+// pool returns the status of the pool with the given ID, whether it is
+// attached to a listener or to the load balancer only.
+func (t *LBStatusTreeV2) pool(id string) *LBPoolStatusV2 {
+	for i := range t.Pools {
+		if t.Pools[i].ID == id {
+			return &t.Pools[i]
+		}
+	}
+	for _, listener := range t.Listeners {
+		for i := range listener.Pools {
+			if listener.Pools[i].ID == id {
+				return &listener.Pools[i]
+			}
+		}
+	}
+	return nil
+}
+
+// waitForLBV2MembersHealthy waits until the members memberIDs of a pool are
+// reported ONLINE by the health monitor of the pool.
+func waitForLBV2MembersHealthy(networkingClient *gophercloud.ServiceClient, poolID string, memberIDs []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for members %v of pool %s to become ONLINE.", memberIDs, poolID)
+
+	lbID, err := lbV2LoadBalancerIDviaPool(networkingClient, poolID)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ONLINE"},
+		Pending:    []string{"OFFLINE"},
+		Refresh:    resourceLBV2MembersHealthRefreshFunc(networkingClient, lbID, poolID, memberIDs),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for members of pool %s to become ONLINE: %s", poolID, err)
+	}
+
+	return nil
+}
+
+// resourceLBV2MembersHealthRefreshFunc reports ONLINE once all members are
+// ONLINE and OFFLINE while some are not yet. Members without a health monitor
+// never become ONLINE, so that and errors are reported as failures.
+func resourceLBV2MembersHealthRefreshFunc(networkingClient *gophercloud.ServiceClient, lbID, poolID string, memberIDs []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		tree, err := lbV2GetStatusTree(networkingClient, lbID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		pool := tree.pool(poolID)
+		if pool == nil {
+			return tree, "OFFLINE", nil
+		}
+
+		statuses := make(map[string]string, len(pool.Members))
+		for _, member := range pool.Members {
+			statuses[member.ID] = member.OperatingStatus
+		}
+
+		for _, id := range memberIDs {
+			switch status := statuses[id]; status {
+			case "ONLINE":
+				continue
+			case "NO_MONITOR":
+				return nil, "", fmt.Errorf("Pool %s has no health monitor, member %s is never reported ONLINE", poolID, id)
+			case "ERROR", "DISABLED":
+				return nil, "", fmt.Errorf("Member %s of pool %s is %s", id, poolID, status)
+			default:
+				log.Printf("[DEBUG] Member %s of pool %s is %q", id, poolID, status)
+				return tree, "OFFLINE", nil
+			}
+		}
+
+		return tree, "ONLINE", nil
+	}
 }
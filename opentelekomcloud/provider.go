@@ -27,6 +27,14 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["secret_key"],
 			},
 
+			"security_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_SECURITY_TOKEN", ""),
+				Description: descriptions["security_token"],
+			},
+
 			"auth_url": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -156,19 +164,28 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"opentelekomcloud_images_image_v2":           dataSourceImagesImageV2(),
-			"opentelekomcloud_networking_network_v2":     dataSourceNetworkingNetworkV2(),
-			"opentelekomcloud_networking_secgroup_v2":    dataSourceNetworkingSecGroupV2(),
-			"opentelekomcloud_s3_bucket_object":          dataSourceS3BucketObject(),
-			"opentelekomcloud_kms_key_v1":                dataSourceKmsKeyV1(),
-			"opentelekomcloud_kms_data_key_v1":           dataSourceKmsDataKeyV1(),
-			"opentelekomcloud_rds_flavors_v1":            dataSourceRdsFlavorV1(),
-			"opentelekomcloud_vpc_v1":                    dataSourceVirtualPrivateCloudVpcV1(),
-			"opentelekomcloud_vpc_peering_connection_v2": dataSourceVpcPeeringConnectionV2(),
-			"opentelekomcloud_vpc_route_v2":              dataSourceVPCRouteV2(),
-			"opentelekomcloud_vpc_route_ids_v2":          dataSourceVPCRouteIdsV2(),
-			"opentelekomcloud_vpc_subnet_v1":             dataSourceVpcSubnetV1(),
-			"opentelekomcloud_vpc_subnet_ids_v1":         dataSourceVpcSubnetIdsV1(),
+			"opentelekomcloud_compute_bms_flavors_v2":        dataSourceComputeBMSFlavorsV2(),
+			"opentelekomcloud_compute_bms_nic_v2":            dataSourceComputeBMSNicV2(),
+			"opentelekomcloud_compute_flavor_v2":             dataSourceComputeFlavorV2(),
+			"opentelekomcloud_compute_availability_zones_v2": dataSourceComputeAvailabilityZonesV2(),
+			"opentelekomcloud_dc_connection_v2":              dataSourceDCConnectionV2(),
+			"opentelekomcloud_deh_host_v1":                   dataSourceDehHostV1(),
+			"opentelekomcloud_images_image_v2":               dataSourceImagesImageV2(),
+			"opentelekomcloud_lb_loadbalancer_status_v2":     dataSourceLBLoadBalancerStatusV2(),
+			"opentelekomcloud_networking_network_v2":         dataSourceNetworkingNetworkV2(),
+			"opentelekomcloud_networking_secgroup_v2":        dataSourceNetworkingSecGroupV2(),
+			"opentelekomcloud_s3_bucket_object":              dataSourceS3BucketObject(),
+			"opentelekomcloud_s3_bucket_objects":             dataSourceS3BucketObjects(),
+			"opentelekomcloud_s3_buckets":                    dataSourceS3Buckets(),
+			"opentelekomcloud_kms_key_v1":                    dataSourceKmsKeyV1(),
+			"opentelekomcloud_kms_data_key_v1":               dataSourceKmsDataKeyV1(),
+			"opentelekomcloud_rds_flavors_v1":                dataSourceRdsFlavorV1(),
+			"opentelekomcloud_vpc_v1":                        dataSourceVirtualPrivateCloudVpcV1(),
+			"opentelekomcloud_vpc_peering_connection_v2":     dataSourceVpcPeeringConnectionV2(),
+			"opentelekomcloud_vpc_route_v2":                  dataSourceVPCRouteV2(),
+			"opentelekomcloud_vpc_route_ids_v2":              dataSourceVPCRouteIdsV2(),
+			"opentelekomcloud_vpc_subnet_v1":                 dataSourceVpcSubnetV1(),
+			"opentelekomcloud_vpc_subnet_ids_v1":             dataSourceVpcSubnetIdsV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -180,18 +197,33 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_compute_floatingip_v2":              resourceComputeFloatingIPV2(),
 			"opentelekomcloud_compute_floatingip_associate_v2":    resourceComputeFloatingIPAssociateV2(),
 			"opentelekomcloud_compute_volume_attach_v2":           resourceComputeVolumeAttachV2(),
+			"opentelekomcloud_compute_interface_attach_v2":        resourceComputeInterfaceAttachV2(),
+			"opentelekomcloud_compute_bms_server_v2":              resourceComputeBMSServerV2(),
+			"opentelekomcloud_dc_virtual_gateway_v2":              resourceDCVirtualGatewayV2(),
+			"opentelekomcloud_dc_virtual_interface_v2":            resourceDCVirtualInterfaceV2(),
+			"opentelekomcloud_deh_host_v1":                        resourceDehHostV1(),
 			"opentelekomcloud_dns_recordset_v2":                   resourceDNSRecordSetV2(),
 			"opentelekomcloud_dns_zone_v2":                        resourceDNSZoneV2(),
+			"opentelekomcloud_ecs_instance_v1":                    resourceEcsInstanceV1(),
 			"opentelekomcloud_fw_firewall_group_v2":               resourceFWFirewallGroupV2(),
 			"opentelekomcloud_fw_policy_v2":                       resourceFWPolicyV2(),
 			"opentelekomcloud_fw_rule_v2":                         resourceFWRuleV2(),
 			"opentelekomcloud_images_image_v2":                    resourceImagesImageV2(),
+			"opentelekomcloud_images_image_access_v2":             resourceImagesImageAccessV2(),
+			"opentelekomcloud_images_image_access_accept_v2":      resourceImagesImageAccessAcceptV2(),
+			"opentelekomcloud_ims_image_v2":                       resourceImsImageV2(),
+			"opentelekomcloud_ims_obs_image_v2":                   resourceImsObsImageV2(),
 			"opentelekomcloud_kms_key_v1":                         resourceKmsKeyV1(),
 			"opentelekomcloud_lb_loadbalancer_v2":                 resourceLoadBalancerV2(),
 			"opentelekomcloud_lb_listener_v2":                     resourceListenerV2(),
 			"opentelekomcloud_lb_pool_v2":                         resourcePoolV2(),
 			"opentelekomcloud_lb_member_v2":                       resourceMemberV2(),
+			"opentelekomcloud_lb_members_v2":                      resourceMembersV2(),
 			"opentelekomcloud_lb_monitor_v2":                      resourceMonitorV2(),
+			"opentelekomcloud_lb_certificate_v2":                  resourceCertificateV2(),
+			"opentelekomcloud_lb_l7policy_v2":                     resourceL7PolicyV2(),
+			"opentelekomcloud_lb_l7rule_v2":                       resourceL7RuleV2(),
+			"opentelekomcloud_lb_whitelist_v2":                    resourceWhitelistV2(),
 			"opentelekomcloud_networking_network_v2":              resourceNetworkingNetworkV2(),
 			"opentelekomcloud_networking_subnet_v2":               resourceNetworkingSubnetV2(),
 			"opentelekomcloud_networking_floatingip_v2":           resourceNetworkingFloatingIPV2(),
@@ -201,13 +233,17 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_networking_router_route_v2":         resourceNetworkingRouterRouteV2(),
 			"opentelekomcloud_networking_secgroup_v2":             resourceNetworkingSecGroupV2(),
 			"opentelekomcloud_networking_secgroup_rule_v2":        resourceNetworkingSecGroupRuleV2(),
+			"opentelekomcloud_networking_secgroup_rules_v2":       resourceNetworkingSecGroupRulesV2(),
 			"opentelekomcloud_s3_bucket":                          resourceS3Bucket(),
 			"opentelekomcloud_s3_bucket_policy":                   resourceS3BucketPolicy(),
 			"opentelekomcloud_s3_bucket_object":                   resourceS3BucketObject(),
+			"opentelekomcloud_s3_bucket_objects":                  resourceS3BucketObjects(),
 			"opentelekomcloud_elb_loadbalancer":                   resourceELoadBalancer(),
 			"opentelekomcloud_elb_listener":                       resourceEListener(),
 			"opentelekomcloud_elb_backend":                        resourceBackend(),
+			"opentelekomcloud_elb_backends":                       resourceBackends(),
 			"opentelekomcloud_elb_health":                         resourceHealth(),
+			"opentelekomcloud_elb_certificate":                    resourceECertificate(),
 			"opentelekomcloud_ces_alarmrule":                      resourceAlarmRule(),
 			"opentelekomcloud_smn_topic_v2":                       resourceTopic(),
 			"opentelekomcloud_smn_subscription_v2":                resourceSubscription(),
@@ -218,6 +254,11 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_vpc_peering_connection_accepter_v2": resourceVpcPeeringConnectionAccepterV2(),
 			"opentelekomcloud_vpc_route_v2":                       resourceVPCRouteV2(),
 			"opentelekomcloud_vpc_subnet_v1":                      resourceVpcSubnetV1(),
+			"opentelekomcloud_vpnaas_service_v2":                  resourceVpnServiceV2(),
+			"opentelekomcloud_vpnaas_ike_policy_v2":               resourceVpnIKEPolicyV2(),
+			"opentelekomcloud_vpnaas_ipsec_policy_v2":             resourceVpnIPSecPolicyV2(),
+			"opentelekomcloud_vpnaas_endpoint_group_v2":           resourceVpnEndpointGroupV2(),
+			"opentelekomcloud_vpnaas_site_connection_v2":          resourceVpnSiteConnectionV2(),
 		},
 
 		ConfigureFunc: configureProvider,
@@ -234,6 +275,8 @@ func init() {
 		"secret_key": "The secret key for API operations. You can retrieve this\n" +
 			"from the 'My Credential' section of the console.",
 
+		"security_token": "The security token of temporary access and secret keys.",
+
 		"auth_url": "The Identity authentication URL.",
 
 		"region": "The OpenTelekomCloud region to connect to.",
@@ -285,6 +328,7 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 		Insecure:         d.Get("insecure").(bool),
 		Password:         d.Get("password").(string),
 		Region:           d.Get("region").(string),
+		SecurityToken:    d.Get("security_token").(string),
 		Swauth:           d.Get("swauth").(bool),
 		Token:            d.Get("token").(string),
 		TenantID:         d.Get("tenant_id").(string),
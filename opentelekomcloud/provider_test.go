@@ -13,6 +13,7 @@ import (
 )
 
 var (
+	OS_DC_CONNECTION_ID       = os.Getenv("OS_DC_CONNECTION_ID")
 	OS_DEPRECATED_ENVIRONMENT = os.Getenv("OS_DEPRECATED_ENVIRONMENT")
 	OS_DNS_ENVIRONMENT        = os.Getenv("OS_DNS_ENVIRONMENT")
 	OS_EXTGW_ID               = os.Getenv("OS_EXTGW_ID")
@@ -20,6 +21,11 @@ var (
 	OS_FLAVOR_NAME            = os.Getenv("OS_FLAVOR_NAME")
 	OS_IMAGE_ID               = os.Getenv("OS_IMAGE_ID")
 	OS_IMAGE_NAME             = os.Getenv("OS_IMAGE_NAME")
+	OS_IMAGE_LOCAL_PATH       = os.Getenv("OS_IMAGE_LOCAL_PATH")
+	OS_IMAGE_SHARE_PROJECT    = os.Getenv("OS_IMAGE_SHARE_PROJECT")
+	OS_DEH_HOST_TYPE          = os.Getenv("OS_DEH_HOST_TYPE")
+	OS_BMS_FLAVOR_NAME        = os.Getenv("OS_BMS_FLAVOR_NAME")
+	OS_BMS_IMAGE_ID           = os.Getenv("OS_BMS_IMAGE_ID")
 	OS_NETWORK_ID             = os.Getenv("OS_NETWORK_ID")
 	OS_POOL_NAME              = os.Getenv("OS_POOL_NAME")
 	OS_REGION_NAME            = os.Getenv("OS_REGION_NAME")
@@ -30,6 +36,8 @@ var (
 	OS_VPC_ID                 = os.Getenv("OS_VPC_ID")
 	OS_SUBNET_ID              = os.Getenv("OS_SUBNET_ID")
 	OS_TENANT_ID              = os.Getenv("OS_TENANT_ID")
+	OS_OBS_REPLICATION_AGENCY = os.Getenv("OS_OBS_REPLICATION_AGENCY")
+	OS_OBS_REPLICATION_BUCKET = os.Getenv("OS_OBS_REPLICATION_BUCKET")
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -141,6 +149,54 @@ func testAccPreCheckSwift(t *testing.T) {
 	}
 }
 
+func testAccPreCheckDC(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_DC_CONNECTION_ID == "" {
+		t.Skip("OS_DC_CONNECTION_ID must be set for Direct Connect acceptance tests")
+	}
+}
+
+func testAccPreCheckImageLocalPath(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_IMAGE_LOCAL_PATH == "" {
+		t.Skip("OS_IMAGE_LOCAL_PATH must be set for image import acceptance tests")
+	}
+}
+
+func testAccPreCheckImageShare(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_IMAGE_SHARE_PROJECT == "" {
+		t.Skip("OS_IMAGE_SHARE_PROJECT must be set to a second project ID for image sharing acceptance tests")
+	}
+}
+
+func testAccPreCheckDeh(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_DEH_HOST_TYPE == "" {
+		t.Skip("OS_DEH_HOST_TYPE must be set for dedicated host acceptance tests")
+	}
+}
+
+func testAccPreCheckBMS(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_BMS_FLAVOR_NAME == "" || OS_BMS_IMAGE_ID == "" {
+		t.Skip("OS_BMS_FLAVOR_NAME and OS_BMS_IMAGE_ID must be set for bare metal server acceptance tests")
+	}
+}
+
+func testAccPreCheckS3Replication(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_OBS_REPLICATION_AGENCY == "" || OS_OBS_REPLICATION_BUCKET == "" {
+		t.Skip("OS_OBS_REPLICATION_AGENCY and OS_OBS_REPLICATION_BUCKET must be set for bucket replication acceptance tests")
+	}
+}
+
 func testAccPreCheckAdminOnly(t *testing.T) {
 	v := os.Getenv("OS_USERNAME")
 	if v != "admin" {
@@ -0,0 +1,342 @@
+package opentelekomcloud
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Bare metal servers are provisioned through the compute API like instances,
+// but installing the image on a physical host takes considerably longer.
+func resourceComputeBMSServerV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeBMSServerV2Create,
+		Read:   resourceComputeBMSServerV2Read,
+		Update: resourceComputeBMSServerV2Update,
+		Delete: resourceComputeBMSServerV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"image_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"flavor_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"user_data": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				// just stash the hash for state & diff comparisons
+				StateFunc: func(v interface{}) string {
+					switch v.(type) {
+					case string:
+						hash := sha1.Sum([]byte(v.(string)))
+						return hex.EncodeToString(hash[:])
+					default:
+						return ""
+					}
+				},
+			},
+			"security_groups": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The networks are configured and read back exactly like the
+			// ones of opentelekomcloud_compute_instance_v2.
+			"network": resourceComputeInstanceV2().Schema["network"],
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"admin_pass": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key_pair": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"access_ip_v4": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_ip_v6": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"all_metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"host_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeBMSServerV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	imageId, err := getImageIDFromConfig(computeClient, d)
+	if err != nil {
+		return err
+	}
+
+	flavorId, err := getFlavorID(computeClient, d)
+	if err != nil {
+		return err
+	}
+
+	allInstanceNetworks, err := getAllInstanceNetworks(d, meta)
+	if err != nil {
+		return err
+	}
+
+	var createOpts servers.CreateOptsBuilder = &servers.CreateOpts{
+		Name:             d.Get("name").(string),
+		ImageRef:         imageId,
+		FlavorRef:        flavorId,
+		SecurityGroups:   resourceInstanceSecGroupsV2(d),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		Networks:         expandInstanceNetworks(allInstanceNetworks),
+		Metadata:         resourceInstanceMetadataV2(d),
+		AdminPass:        d.Get("admin_pass").(string),
+		UserData:         []byte(d.Get("user_data").(string)),
+	}
+
+	if keyName, ok := d.Get("key_pair").(string); ok && keyName != "" {
+		createOpts = &keypairs.CreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			KeyName:           keyName,
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	server, err := servers.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud bare metal server: %s", err)
+	}
+	log.Printf("[INFO] Bare metal server ID: %s", server.ID)
+
+	d.SetId(server.ID)
+
+	log.Printf("[DEBUG] Waiting for bare metal server (%s) to become running", server.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"BUILD"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    ServerV2StateRefreshFunc(computeClient, server.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      60 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for bare metal server (%s) to become ready: %s", server.ID, err)
+	}
+
+	return resourceComputeBMSServerV2Read(d, meta)
+}
+
+func resourceComputeBMSServerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	var server struct {
+		servers.Server
+		availabilityzones.ServerExt
+	}
+	err = servers.Get(computeClient, d.Id()).ExtractInto(&server)
+	if err != nil {
+		return CheckDeleted(d, err, "bare metal server")
+	}
+
+	log.Printf("[DEBUG] Retrieved bare metal server %s: %+v", d.Id(), server)
+
+	d.Set("name", server.Name)
+	d.Set("status", server.Status)
+	d.Set("host_id", server.HostID)
+	d.Set("availability_zone", server.AvailabilityZone)
+
+	networks, err := flattenInstanceNetworks(d, meta)
+	if err != nil {
+		return err
+	}
+
+	hostv4, hostv6 := getInstanceAccessAddresses(d, networks)
+	if err := d.Set("network", networks); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving network to state for OpenTelekomCloud bare metal server (%s): %s", d.Id(), err)
+	}
+	d.Set("access_ip_v4", hostv4)
+	d.Set("access_ip_v6", hostv6)
+
+	if hostv4 != "" || hostv6 != "" {
+		host := hostv4
+		if host == "" {
+			host = hostv6
+		}
+		d.SetConnInfo(map[string]string{
+			"type": "ssh",
+			"host": host,
+		})
+	}
+
+	if err := d.Set("all_metadata", server.Metadata); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving all_metadata to state for OpenTelekomCloud bare metal server (%s): %s", d.Id(), err)
+	}
+
+	secGrpNames := []string{}
+	for _, sg := range server.SecurityGroups {
+		secGrpNames = append(secGrpNames, sg["name"].(string))
+	}
+	if err := d.Set("security_groups", secGrpNames); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving security_groups to state for OpenTelekomCloud bare metal server (%s): %s", d.Id(), err)
+	}
+
+	flavorId, ok := server.Flavor["id"].(string)
+	if !ok {
+		return fmt.Errorf("Error setting OpenTelekomCloud bare metal server's flavor: %v", server.Flavor)
+	}
+	d.Set("flavor_id", flavorId)
+
+	flavor, err := flavors.Get(computeClient, flavorId).Extract()
+	if err != nil {
+		return err
+	}
+	d.Set("flavor_name", flavor.Name)
+
+	if err := setImageInformation(computeClient, &server.Server, d); err != nil {
+		return err
+	}
+
+	d.Set("key_pair", server.KeyName)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceComputeBMSServerV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		updateOpts := servers.UpdateOpts{
+			Name: d.Get("name").(string),
+		}
+		_, err := servers.Update(computeClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud bare metal server: %s", err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		if err := resourceComputeInstanceV2UpdateMetadata(computeClient, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeBMSServerV2Read(d, meta)
+}
+
+func resourceComputeBMSServerV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting OpenTelekomCloud bare metal server %s", d.Id())
+	err = servers.Delete(computeClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "bare metal server")
+	}
+
+	log.Printf("[DEBUG] Waiting for bare metal server (%s) to delete", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "SHUTOFF", "DELETING"},
+		Target:     []string{"DELETED", "SOFT_DELETED"},
+		Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      60 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for bare metal server (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,144 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+func TestAccComputeV2BMSServer_basic(t *testing.T) {
+	var server servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBMS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2BMSServerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2BMSServer_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2BMSServerExists("opentelekomcloud_compute_bms_server_v2.server_1", &server),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_bms_server_v2.server_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_bms_server_v2.server_1", "flavor_name", OS_BMS_FLAVOR_NAME),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_bms_server_v2.server_1", "all_metadata.foo", "bar"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_compute_bms_server_v2.server_1", "access_ip_v4"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2BMSServer_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2BMSServerExists("opentelekomcloud_compute_bms_server_v2.server_1", &server),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_bms_server_v2.server_1", "name", "bms_server_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_bms_server_v2.server_1", "all_metadata.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2BMSServerDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	computeClient, err := config.computeV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_compute_bms_server_v2" {
+			continue
+		}
+
+		server, err := servers.Get(computeClient, rs.Primary.ID).Extract()
+		if err == nil {
+			if server.Status != "SOFT_DELETED" {
+				return fmt.Errorf("Bare metal server still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeV2BMSServerExists(n string, server *servers.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
+		}
+
+		found, err := servers.Get(computeClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Bare metal server not found")
+		}
+
+		*server = *found
+
+		return nil
+	}
+}
+
+var testAccComputeV2BMSServer_basic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_keypair_v2" "kp_1" {
+  name = "kp_bms"
+}
+
+resource "opentelekomcloud_compute_bms_server_v2" "server_1" {
+  name = "bms_server"
+  image_id = "%s"
+  flavor_name = "%s"
+  availability_zone = "%s"
+  key_pair = "${opentelekomcloud_compute_keypair_v2.kp_1.name}"
+  security_groups = ["default"]
+  metadata {
+    foo = "bar"
+  }
+  network {
+    uuid = "%s"
+  }
+}
+`, OS_BMS_IMAGE_ID, OS_BMS_FLAVOR_NAME, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
+
+var testAccComputeV2BMSServer_update = fmt.Sprintf(`
+resource "opentelekomcloud_compute_keypair_v2" "kp_1" {
+  name = "kp_bms"
+}
+
+resource "opentelekomcloud_compute_bms_server_v2" "server_1" {
+  name = "bms_server_updated"
+  image_id = "%s"
+  flavor_name = "%s"
+  availability_zone = "%s"
+  key_pair = "${opentelekomcloud_compute_keypair_v2.kp_1.name}"
+  security_groups = ["default"]
+  metadata {
+    foo = "baz"
+  }
+  network {
+    uuid = "%s"
+  }
+}
+`, OS_BMS_IMAGE_ID, OS_BMS_FLAVOR_NAME, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
@@ -52,16 +52,18 @@ func resourceComputeInstanceV2() *schema.Resource {
 				ForceNew: false,
 			},
 			"image_id": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				Computed:         true,
+				DiffSuppressFunc: suppressComputeInstanceV2ImageDiff,
 			},
 			"image_name": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				Computed:         true,
+				DiffSuppressFunc: suppressComputeInstanceV2ImageDiff,
 			},
 			"flavor_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -304,6 +306,19 @@ func resourceComputeInstanceV2() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"tenancy": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"shared", "dedicated"})
+							},
+						},
+						"dedicated_host_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
 					},
 				},
 				Set: resourceComputeSchedulerHintsHash,
@@ -331,6 +346,14 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"power_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"active", "shutoff"})
+				},
+			},
 			"tags": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -497,6 +520,14 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 			log.Printf("[WARN] Error setting auto recovery of instance:%s, err=%s", server.ID, err)
 		}
 	}
+
+	if d.Get("power_state").(string) == "shutoff" {
+		err = resourceComputeInstanceV2SetPowerState(computeClient, d.Id(), "shutoff", d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceComputeInstanceV2Read(d, meta)
 }
 
@@ -587,6 +618,13 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	switch server.Status {
+	case "ACTIVE":
+		d.Set("power_state", "active")
+	case "SHUTOFF":
+		d.Set("power_state", "shutoff")
+	}
+
 	// Build a custom struct for the availability zone extension
 	var serverWithAZ struct {
 		servers.Server
@@ -689,6 +727,15 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
 	}
 
+	// Start a stopped instance first, so that the remaining changes are
+	// applied to a running one.
+	if d.HasChange("power_state") && d.Get("power_state").(string) == "active" {
+		err = resourceComputeInstanceV2SetPowerState(computeClient, d.Id(), "active", d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	var updateOpts servers.UpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
@@ -702,40 +749,8 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if d.HasChange("metadata") {
-		oldMetadata, newMetadata := d.GetChange("metadata")
-		var metadataToDelete []string
-
-		// Determine if any metadata keys were removed from the configuration.
-		// Then request those keys to be deleted.
-		for oldKey, _ := range oldMetadata.(map[string]interface{}) {
-			var found bool
-			for newKey, _ := range newMetadata.(map[string]interface{}) {
-				if oldKey == newKey {
-					found = true
-				}
-			}
-
-			if !found {
-				metadataToDelete = append(metadataToDelete, oldKey)
-			}
-		}
-
-		for _, key := range metadataToDelete {
-			err := servers.DeleteMetadatum(computeClient, d.Id(), key).ExtractErr()
-			if err != nil {
-				return fmt.Errorf("Error deleting metadata (%s) from server (%s): %s", key, d.Id(), err)
-			}
-		}
-
-		// Update existing metadata and add any new metadata.
-		metadataOpts := make(servers.MetadataOpts)
-		for k, v := range newMetadata.(map[string]interface{}) {
-			metadataOpts[k] = v.(string)
-		}
-
-		_, err := servers.UpdateMetadata(computeClient, d.Id(), metadataOpts).Extract()
-		if err != nil {
-			return fmt.Errorf("Error updating OpenTelekomCloud server (%s) metadata: %s", d.Id(), err)
+		if err := resourceComputeInstanceV2UpdateMetadata(computeClient, d); err != nil {
+			return err
 		}
 	}
 
@@ -772,6 +787,12 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("image_id") || d.HasChange("image_name") {
+		if err := resourceComputeInstanceV2Rebuild(computeClient, d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("admin_pass") {
 		if newPwd, ok := d.Get("admin_pass").(string); ok {
 			err := servers.ChangeAdminPassword(computeClient, d.Id(), newPwd).ExtractErr()
@@ -829,7 +850,7 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 
 		stateConf = &resource.StateChangeConf{
 			Pending:    []string{"VERIFY_RESIZE"},
-			Target:     []string{"ACTIVE"},
+			Target:     []string{"ACTIVE", "SHUTOFF"},
 			Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
 			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			Delay:      10 * time.Second,
@@ -868,6 +889,13 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("power_state") && d.Get("power_state").(string) == "shutoff" {
+		err = resourceComputeInstanceV2SetPowerState(computeClient, d.Id(), "shutoff", d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceComputeInstanceV2Read(d, meta)
 }
 
@@ -878,8 +906,9 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OpenTelekomCloud compute client: %s", err)
 	}
 
-	if d.Get("stop_before_destroy").(bool) {
-		err = startstop.Stop(computeClient, d.Id()).ExtractErr()
+	// An instance with a power_state of shutoff is stopped already
+	if d.Get("stop_before_destroy").(bool) && d.Get("power_state").(string) != "shutoff" {
+		err = resourceComputeInstanceV2SetPowerState(computeClient, d.Id(), "shutoff", d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			log.Printf("[WARN] Error stopping OpenTelekomCloud instance: %s", err)
 		}
@@ -922,6 +951,126 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// resourceComputeInstanceV2SetPowerState starts or stops the instance and
+// waits for it to reach the requested power state, "active" or "shutoff".
+func resourceComputeInstanceV2SetPowerState(client *gophercloud.ServiceClient, id, state string, timeout time.Duration) error {
+	var pending, target string
+	if state == "shutoff" {
+		log.Printf("[DEBUG] Stopping instance (%s)", id)
+		if err := startstop.Stop(client, id).ExtractErr(); err != nil {
+			return fmt.Errorf("Error stopping OpenTelekomCloud server (%s): %s", id, err)
+		}
+		pending, target = "ACTIVE", "SHUTOFF"
+	} else {
+		log.Printf("[DEBUG] Starting instance (%s)", id)
+		if err := startstop.Start(client, id).ExtractErr(); err != nil {
+			return fmt.Errorf("Error starting OpenTelekomCloud server (%s): %s", id, err)
+		}
+		pending, target = "SHUTOFF", "ACTIVE"
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{pending},
+		Target:     []string{target},
+		Refresh:    ServerV2StateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to become %s: %s", id, state, err)
+	}
+	return nil
+}
+
+// resourceComputeInstanceV2Rebuild rebuilds the instance in place with the
+// new image. The ID, ports and attached volumes of the instance are kept.
+func resourceComputeInstanceV2Rebuild(client *gophercloud.ServiceClient, d *schema.ResourceData) error {
+	var imageId string
+	if d.HasChange("image_id") {
+		imageId = d.Get("image_id").(string)
+	} else {
+		var err error
+		imageId, err = images.IDFromName(client, d.Get("image_name").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	if id, err := getImageIDFromConfig(client, d); err != nil {
+		return err
+	} else if id == "" {
+		return fmt.Errorf("Error rebuilding OpenTelekomCloud server (%s): an instance booted from a volume can not be rebuilt", d.Id())
+	}
+
+	rebuildOpts := &servers.RebuildOpts{
+		ImageID:   imageId,
+		AdminPass: d.Get("admin_pass").(string),
+	}
+	log.Printf("[DEBUG] Rebuild configuration: %#v", rebuildOpts)
+	if _, err := servers.Rebuild(client, d.Id(), rebuildOpts).Extract(); err != nil {
+		return fmt.Errorf("Error rebuilding OpenTelekomCloud server (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to finish rebuilding", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"REBUILD"},
+		Target:     []string{"ACTIVE", "SHUTOFF"},
+		Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to rebuild: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// resourceComputeInstanceV2UpdateMetadata applies the changes of the metadata
+// argument to the server.
+func resourceComputeInstanceV2UpdateMetadata(computeClient *gophercloud.ServiceClient, d *schema.ResourceData) error {
+	oldMetadata, newMetadata := d.GetChange("metadata")
+	var metadataToDelete []string
+
+	// Determine if any metadata keys were removed from the configuration.
+	// Then request those keys to be deleted.
+	for oldKey, _ := range oldMetadata.(map[string]interface{}) {
+		var found bool
+		for newKey, _ := range newMetadata.(map[string]interface{}) {
+			if oldKey == newKey {
+				found = true
+			}
+		}
+
+		if !found {
+			metadataToDelete = append(metadataToDelete, oldKey)
+		}
+	}
+
+	for _, key := range metadataToDelete {
+		err := servers.DeleteMetadatum(computeClient, d.Id(), key).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error deleting metadata (%s) from server (%s): %s", key, d.Id(), err)
+		}
+	}
+
+	// Update existing metadata and add any new metadata.
+	metadataOpts := make(servers.MetadataOpts)
+	for k, v := range newMetadata.(map[string]interface{}) {
+		metadataOpts[k] = v.(string)
+	}
+
+	_, err := servers.UpdateMetadata(computeClient, d.Id(), metadataOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud server (%s) metadata: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
 // ServerV2StateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // an OpenTelekomCloud instance.
 func ServerV2StateRefreshFunc(client *gophercloud.ServiceClient, instanceID string) resource.StateRefreshFunc {
@@ -1027,24 +1176,54 @@ func resourceInstanceSchedulerHintsV2(d *schema.ResourceData, schedulerHintsRaw
 		BuildNearHostIP: schedulerHintsRaw["build_near_host_ip"].(string),
 	}
 
+	// Placement on dedicated hosts uses OpenTelekomCloud specific hints
+	tenancy := schedulerHintsRaw["tenancy"].(string)
+	dehId := schedulerHintsRaw["dedicated_host_id"].(string)
+	if dehId != "" && tenancy == "" {
+		tenancy = "dedicated"
+	}
+	if tenancy != "" || dehId != "" {
+		schedulerHints.AdditionalProperties = make(map[string]interface{})
+		if tenancy != "" {
+			schedulerHints.AdditionalProperties["tenancy"] = tenancy
+		}
+		if dehId != "" {
+			schedulerHints.AdditionalProperties["dedicated_host_id"] = dehId
+		}
+	}
+
 	return schedulerHints
 }
 
-func getImageIDFromConfig(computeClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
-	// If block_device was used, an Image does not need to be specified, unless an image/local
-	// combination was used. This emulates normal boot behavior. Otherwise, ignore the image altogether.
-	if vL, ok := d.GetOk("block_device"); ok {
-		needImage := false
-		for _, v := range vL.([]interface{}) {
-			vM := v.(map[string]interface{})
-			if vM["source_type"] == "image" && vM["destination_type"] == "local" {
-				needImage = true
-			}
-		}
-		if !needImage {
-			return "", nil
+// computeInstanceV2BootsFromVolume reports whether the instance boots from a
+// volume of block_device. If block_device was used, an Image does not need to
+// be specified, unless an image/local combination was used. This emulates
+// normal boot behavior. Otherwise, the image is ignored altogether.
+func computeInstanceV2BootsFromVolume(d *schema.ResourceData) bool {
+	vL, ok := d.GetOk("block_device")
+	if !ok {
+		return false
+	}
+	for _, v := range vL.([]interface{}) {
+		vM := v.(map[string]interface{})
+		if vM["source_type"] == "image" && vM["destination_type"] == "local" {
+			return false
 		}
 	}
+	return true
+}
+
+// suppressComputeInstanceV2ImageDiff ignores changes of the image of an
+// instance booted from a volume. Such an instance can not be rebuilt, and its
+// boot volume is set by block_device, which forces a new instance instead.
+func suppressComputeInstanceV2ImageDiff(k, old, new string, d *schema.ResourceData) bool {
+	return computeInstanceV2BootsFromVolume(d)
+}
+
+func getImageIDFromConfig(computeClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
+	if computeInstanceV2BootsFromVolume(d) {
+		return "", nil
+	}
 
 	if imageId := d.Get("image_id").(string); imageId != "" {
 		return imageId, nil
@@ -1075,20 +1254,9 @@ func getImageIDFromConfig(computeClient *gophercloud.ServiceClient, d *schema.Re
 }
 
 func setImageInformation(computeClient *gophercloud.ServiceClient, server *servers.Server, d *schema.ResourceData) error {
-	// If block_device was used, an Image does not need to be specified, unless an image/local
-	// combination was used. This emulates normal boot behavior. Otherwise, ignore the image altogether.
-	if vL, ok := d.GetOk("block_device"); ok {
-		needImage := false
-		for _, v := range vL.([]interface{}) {
-			vM := v.(map[string]interface{})
-			if vM["source_type"] == "image" && vM["destination_type"] == "local" {
-				needImage = true
-			}
-		}
-		if !needImage {
-			d.Set("image_id", "Attempt to boot from volume - no image supplied")
-			return nil
-		}
+	if computeInstanceV2BootsFromVolume(d) {
+		d.Set("image_id", "Attempt to boot from volume - no image supplied")
+		return nil
 	}
 
 	imageId := server.Image["id"].(string)
@@ -1142,6 +1310,15 @@ func resourceComputeSchedulerHintsHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["same_host"].([]interface{})))
 	buf.WriteString(fmt.Sprintf("%s-", m["query"].([]interface{})))
 
+	// Only added when set, so the hash of existing hints does not change
+	if v, ok := m["tenancy"].(string); ok && v != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := m["dedicated_host_id"].(string); ok && v != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
 	return hashcode.String(buf.String())
 }
 
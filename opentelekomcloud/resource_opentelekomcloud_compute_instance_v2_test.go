@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 
 	"github.com/gophercloud/gophercloud"
@@ -266,6 +267,65 @@ func TestAccComputeV2Instance_stopBeforeDestroy(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_powerState(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_powerState_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("opentelekomcloud_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "SHUTOFF"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_instance_v2.instance_1", "power_state", "shutoff"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_powerState_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("opentelekomcloud_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_instance_v2.instance_1", "power_state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_rebuild(t *testing.T) {
+	var instance1, instance2 servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_rebuild_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("opentelekomcloud_compute_instance_v2.instance_1", &instance1),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_compute_instance_v2.instance_1", "image_id",
+						"data.opentelekomcloud_images_image_v2.image_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_rebuild_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("opentelekomcloud_compute_instance_v2.instance_1", &instance2),
+					testAccCheckComputeV2InstanceInstanceIDsMatch(&instance1, &instance2),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_compute_instance_v2.instance_1", "image_id",
+						"data.opentelekomcloud_images_image_v2.image_2", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_metadataRemove(t *testing.T) {
 	var instance servers.Server
 
@@ -563,6 +623,28 @@ func testAccCheckComputeV2InstanceInstanceIDsDoNotMatch(
 	}
 }
 
+func testAccCheckComputeV2InstanceInstanceIDsMatch(
+	instance1, instance2 *servers.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance1.ID != instance2.ID {
+			return fmt.Errorf("Instance was recreated.")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2InstanceStatus(
+	instance *servers.Server, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance.Status != status {
+			return fmt.Errorf("Instance status is %s, expected %s", instance.Status, status)
+		}
+
+		return nil
+	}
+}
+
 var testAccComputeV2Instance_basic = fmt.Sprintf(`
 resource "opentelekomcloud_compute_instance_v2" "instance_1" {
   name = "instance_1"
@@ -951,6 +1033,72 @@ resource "opentelekomcloud_compute_instance_v2" "instance_1" {
 }
 `, OS_NETWORK_ID)
 
+var testAccComputeV2Instance_powerState_1 = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+  power_state = "shutoff"
+  stop_before_destroy = true
+}
+`, OS_NETWORK_ID)
+
+var testAccComputeV2Instance_powerState_2 = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+  power_state = "active"
+  stop_before_destroy = true
+}
+`, OS_NETWORK_ID)
+
+var testAccComputeV2Instance_rebuild_1 = fmt.Sprintf(`
+data "opentelekomcloud_images_image_v2" "image_1" {
+  name = "Standard_CentOS_7_latest"
+  most_recent = true
+}
+
+data "opentelekomcloud_images_image_v2" "image_2" {
+  name = "Standard_Ubuntu_16.04_latest"
+  most_recent = true
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  image_id = "${data.opentelekomcloud_images_image_v2.image_1.id}"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+`, OS_NETWORK_ID)
+
+var testAccComputeV2Instance_rebuild_2 = fmt.Sprintf(`
+data "opentelekomcloud_images_image_v2" "image_1" {
+  name = "Standard_CentOS_7_latest"
+  most_recent = true
+}
+
+data "opentelekomcloud_images_image_v2" "image_2" {
+  name = "Standard_Ubuntu_16.04_latest"
+  most_recent = true
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  image_id = "${data.opentelekomcloud_images_image_v2.image_2.id}"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+`, OS_NETWORK_ID)
+
 var testAccComputeV2Instance_metadataRemove_1 = fmt.Sprintf(`
 resource "opentelekomcloud_compute_instance_v2" "instance_1" {
   name = "instance_1"
@@ -1175,3 +1323,62 @@ resource "opentelekomcloud_compute_instance_v2" "instance_1" {
   auto_recovery = true
 }
 `, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
+
+func TestComputeInstanceV2BootsFromVolume(t *testing.T) {
+	var testCases = []struct {
+		BlockDevice []interface{}
+		Expected    bool
+	}{
+		{
+			BlockDevice: nil,
+			Expected:    false,
+		},
+		{
+			BlockDevice: []interface{}{
+				map[string]interface{}{
+					"uuid":             "image",
+					"source_type":      "image",
+					"destination_type": "local",
+					"boot_index":       0,
+				},
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "volume",
+					"volume_size":      1,
+					"boot_index":       1,
+				},
+			},
+			Expected: false,
+		},
+		{
+			BlockDevice: []interface{}{
+				map[string]interface{}{
+					"uuid":             "image",
+					"source_type":      "image",
+					"destination_type": "volume",
+					"volume_size":      5,
+					"boot_index":       0,
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		raw := map[string]interface{}{
+			"name":       "instance_1",
+			"image_name": "image",
+		}
+		if tc.BlockDevice != nil {
+			raw["block_device"] = tc.BlockDevice
+		}
+		d := schema.TestResourceDataRaw(t, resourceComputeInstanceV2().Schema, raw)
+
+		if v := computeInstanceV2BootsFromVolume(d); v != tc.Expected {
+			t.Fatalf("%d: expected %t, got %t", i, tc.Expected, v)
+		}
+		if v := suppressComputeInstanceV2ImageDiff("image_name", "image", "other", d); v != tc.Expected {
+			t.Fatalf("%d: expected the image diff to be suppressed: %t, got %t", i, tc.Expected, v)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package opentelekomcloud
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"os"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -33,8 +35,32 @@ func resourceComputeKeypairV2() *schema.Resource {
 			"public_key": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
+			// The private key of a generated keypair is only returned on
+			// creation, so it is kept from then on.
+			"private_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"private_key_file": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"public_key"},
+			},
+			"private_key_kms_key_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"public_key"},
+			},
+			"encrypted_private_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -67,6 +93,12 @@ func resourceComputeKeypairV2Create(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(kp.Name)
 
+	if kp.PrivateKey != "" {
+		if err := resourceComputeKeypairV2StorePrivateKey(d, meta, kp.PrivateKey); err != nil {
+			return err
+		}
+	}
+
 	return resourceComputeKeypairV2Read(d, meta)
 }
 
@@ -100,6 +132,53 @@ func resourceComputeKeypairV2Delete(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return fmt.Errorf("Error deleting OpenTelekomCloud keypair: %s", err)
 	}
+
+	if filename := d.Get("private_key_file").(string); filename != "" {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Error removing private key file %q: %s", filename, err)
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
+
+// resourceComputeKeypairV2StorePrivateKey keeps the private key of a keypair
+// generated by the compute service. It is encrypted with
+// private_key_kms_key_id and written to private_key_file when those are set,
+// and stored in private_key otherwise.
+func resourceComputeKeypairV2StorePrivateKey(d *schema.ResourceData, meta interface{}, privateKey string) error {
+	config := meta.(*Config)
+
+	kmsKeyId := d.Get("private_key_kms_key_id").(string)
+	if kmsKeyId != "" {
+		kmsClient, err := config.kmsKeyV1Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud kms key client: %s", err)
+		}
+
+		cipherText, err := kmsV1EncryptData(kmsClient, kmsKeyId, privateKey)
+		if err != nil {
+			return fmt.Errorf("Error encrypting private key of keypair (%s): %s", d.Id(), err)
+		}
+		d.Set("encrypted_private_key", cipherText)
+	}
+
+	filename := d.Get("private_key_file").(string)
+	if filename != "" {
+		log.Printf("[DEBUG] Writing private key of keypair %s to %s", d.Id(), filename)
+		if err := ioutil.WriteFile(filename, []byte(privateKey), 0600); err != nil {
+			return fmt.Errorf("Error writing private key of keypair (%s) to %q: %s", d.Id(), filename, err)
+		}
+		// WriteFile keeps the permissions of an existing file
+		if err := os.Chmod(filename, 0600); err != nil {
+			return fmt.Errorf("Error setting permissions of %q: %s", filename, err)
+		}
+	}
+
+	if kmsKeyId == "" && filename == "" {
+		d.Set("private_key", privateKey)
+	}
+
+	return nil
+}
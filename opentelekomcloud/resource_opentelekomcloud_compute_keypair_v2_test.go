@@ -2,12 +2,18 @@ package opentelekomcloud
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/huaweicloud/golangsdk"
 )
 
 // PASS
@@ -88,3 +94,169 @@ resource "opentelekomcloud_compute_keypair_v2" "kp_1" {
   public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDAjpC1hwiOCCmKEWxJ4qzTTsJbKzndLo1BCz5PcwtUnflmU+gHJtWMZKpuEGVi29h0A/+ydKek1O18k10Ff+4tyFjiHDQAT9+OfgWf7+b1yK+qDip3X1C0UPMbwHlTfSGWLGZquwhvEFx9k3h/M+VtMvwR1lJ9LUyTAImnNjWG7TAIPmui30HvM2UiFEmqkr4ijq45MyX2+fLIePLRIFuu1p4whjHAQYufqyno3BS48icQb4p6iVEZPo4AE2o9oIyQvj2mx4dk5Y8CgSETOZTYDOR3rU2fZTRDRgPJDH9FWvQjF5tA0p3d9CoWWd2s6GKKbfoUIi8R/Db1BSPJwkqB jrp-hp-pc"
 }
 `
+
+func TestAccComputeV2Keypair_generate(t *testing.T) {
+	var keypair keypairs.KeyPair
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2KeypairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Keypair_generate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2KeypairExists("opentelekomcloud_compute_keypair_v2.kp_1", &keypair),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_compute_keypair_v2.kp_1", "public_key"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_compute_keypair_v2.kp_1", "private_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Keypair_privateKeyFile(t *testing.T) {
+	var keypair keypairs.KeyPair
+
+	dir, err := ioutil.TempDir("", "tf-acc-keypair")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "kp_1.pem")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2KeypairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Keypair_privateKeyFile(filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2KeypairExists("opentelekomcloud_compute_keypair_v2.kp_1", &keypair),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_keypair_v2.kp_1", "private_key", ""),
+					testAccCheckComputeV2KeypairPrivateKeyFile(filename),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Keypair_privateKeyKms(t *testing.T) {
+	var keypair keypairs.KeyPair
+	var keyAlias = fmt.Sprintf("kms_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2KeypairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Keypair_privateKeyKms(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2KeypairExists("opentelekomcloud_compute_keypair_v2.kp_1", &keypair),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_keypair_v2.kp_1", "private_key", ""),
+					testAccCheckComputeV2KeypairEncryptedPrivateKey("opentelekomcloud_compute_keypair_v2.kp_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2KeypairPrivateKeyFile(filename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fi, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if fi.Mode().Perm() != 0600 {
+			return fmt.Errorf("Private key file has mode %s, expected 0600", fi.Mode().Perm())
+		}
+
+		b, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(string(b), "PRIVATE KEY") {
+			return fmt.Errorf("Private key file does not contain a private key")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2KeypairEncryptedPrivateKey(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmsClient, err := config.kmsKeyV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud kms client: %s", err)
+		}
+
+		privateKey, err := testAccKmsV1DecryptData(kmsClient, rs.Primary.Attributes["encrypted_private_key"])
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(privateKey, "PRIVATE KEY") {
+			return fmt.Errorf("Encrypted private key does not contain a private key")
+		}
+
+		return nil
+	}
+}
+
+// testAccKmsV1DecryptData decrypts cipherText as returned by
+// kmsV1EncryptData.
+func testAccKmsV1DecryptData(c *golangsdk.ServiceClient, cipherText string) (string, error) {
+	b := map[string]interface{}{
+		"cipher_text": cipherText,
+	}
+	var r struct {
+		PlainText string `json:"plain_text"`
+	}
+	_, err := c.Post(c.ServiceURL(c.ProjectID, "kms", "decrypt-data"), b, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return r.PlainText, nil
+}
+
+const testAccComputeV2Keypair_generate = `
+resource "opentelekomcloud_compute_keypair_v2" "kp_1" {
+  name = "kp_1"
+}
+`
+
+func testAccComputeV2Keypair_privateKeyFile(filename string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_compute_keypair_v2" "kp_1" {
+  name = "kp_1"
+  private_key_file = "%s"
+}
+`, filename)
+}
+
+func testAccComputeV2Keypair_privateKeyKms(keyAlias string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias = "%s"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_compute_keypair_v2" "kp_1" {
+  name = "kp_1"
+  private_key_kms_key_id = "${opentelekomcloud_kms_key_v1.key_1.id}"
+}
+`, keyAlias)
+}
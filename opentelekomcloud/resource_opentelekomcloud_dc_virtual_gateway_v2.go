@@ -0,0 +1,238 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDCVirtualGatewayV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDCVirtualGatewayV2Create,
+		Read:   resourceDCVirtualGatewayV2Read,
+		Update: resourceDCVirtualGatewayV2Update,
+		Delete: resourceDCVirtualGatewayV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateName,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"local_cidrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDR,
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"local_endpoint_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDCVirtualGatewayV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	groupID, err := dcV2CreateCIDREndpointGroup(dcClient, name, d.Get("local_cidrs").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	createOpts := VirtualGatewayCreateOpts{
+		TenantID:       d.Get("tenant_id").(string),
+		VpcID:          d.Get("vpc_id").(string),
+		LocalEPGroupID: groupID,
+		Name:           name,
+		Description:    d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	gw, err := dcV2CreateVirtualGateway(dcClient, createOpts)
+	if err != nil {
+		if delErr := dcV2DeleteEndpointGroup(dcClient, groupID); delErr != nil {
+			log.Printf("[WARN] Error cleaning up Direct Connect endpoint group %s: %s", groupID, delErr)
+		}
+		return fmt.Errorf("Error creating OpenTelekomCloud virtual gateway: %s", err)
+	}
+
+	d.SetId(gw.ID)
+	log.Printf("[INFO] Virtual gateway ID: %s", gw.ID)
+
+	log.Printf("[DEBUG] Waiting for virtual gateway (%s) to become available", gw.ID)
+	err = waitForDCV2Status(resourceDCVirtualGatewayV2RefreshFunc(dcClient, gw.ID),
+		[]string{"PENDING_CREATE", "BUILD"}, "ACTIVE", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for virtual gateway (%s) to become ACTIVE: %s", gw.ID, err)
+	}
+
+	return resourceDCVirtualGatewayV2Read(d, meta)
+}
+
+func resourceDCVirtualGatewayV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	gw, err := dcV2GetVirtualGateway(dcClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "virtual gateway")
+	}
+
+	log.Printf("[DEBUG] Retrieved virtual gateway %s: %#v", d.Id(), gw)
+
+	d.Set("name", gw.Name)
+	d.Set("description", gw.Description)
+	d.Set("vpc_id", gw.VpcID)
+	d.Set("tenant_id", gw.TenantID)
+	d.Set("local_endpoint_group_id", gw.LocalEPGroupID)
+	d.Set("device_id", gw.DeviceID)
+	d.Set("status", gw.Status)
+	d.Set("region", GetRegion(d, config))
+
+	if gw.LocalEPGroupID != "" {
+		group, err := dcV2GetEndpointGroup(dcClient, gw.LocalEPGroupID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving local endpoint group of virtual gateway %s: %s", d.Id(), err)
+		}
+		d.Set("local_cidrs", group.Endpoints)
+	}
+
+	return nil
+}
+
+func resourceDCVirtualGatewayV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	var updateOpts VirtualGatewayUpdateOpts
+	var groupID, oldGroupID string
+
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		updateOpts.Description = d.Get("description").(string)
+	}
+	if d.HasChange("local_cidrs") {
+		// Endpoint groups are immutable, so a replacement group is created
+		// and the old one is removed once the gateway no longer uses it.
+		groupID, err = dcV2CreateCIDREndpointGroup(dcClient, d.Get("name").(string), d.Get("local_cidrs").([]interface{}))
+		if err != nil {
+			return err
+		}
+		updateOpts.LocalEPGroupID = groupID
+		oldGroupID = d.Get("local_endpoint_group_id").(string)
+	}
+
+	log.Printf("[DEBUG] Updating virtual gateway %s with options: %#v", d.Id(), updateOpts)
+	err = dcV2UpdateVirtualGateway(dcClient, d.Id(), updateOpts)
+	if err != nil {
+		if groupID != "" {
+			if delErr := dcV2DeleteEndpointGroup(dcClient, groupID); delErr != nil {
+				log.Printf("[WARN] Error cleaning up Direct Connect endpoint group %s: %s", groupID, delErr)
+			}
+		}
+		return fmt.Errorf("Error updating OpenTelekomCloud virtual gateway: %s", err)
+	}
+
+	if oldGroupID != "" {
+		if err := dcV2DeleteEndpointGroup(dcClient, oldGroupID); err != nil {
+			log.Printf("[WARN] Error deleting Direct Connect endpoint group %s: %s", oldGroupID, err)
+		}
+	}
+
+	return resourceDCVirtualGatewayV2Read(d, meta)
+}
+
+func resourceDCVirtualGatewayV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	groupID := d.Get("local_endpoint_group_id").(string)
+
+	err = dcV2DeleteVirtualGateway(dcClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "virtual gateway")
+	}
+
+	err = waitForDCV2Status(resourceDCVirtualGatewayV2RefreshFunc(dcClient, d.Id()),
+		[]string{"ACTIVE", "PENDING_DELETE"}, "DELETED", d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("Error waiting for virtual gateway (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	if groupID != "" {
+		err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+			err := dcV2DeleteEndpointGroup(dcClient, groupID)
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil && !isResourceNotFound(err) {
+			return fmt.Errorf("Error deleting Direct Connect endpoint group %s: %s", groupID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
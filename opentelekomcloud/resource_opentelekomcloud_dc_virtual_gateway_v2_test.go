@@ -0,0 +1,160 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDCVirtualGatewayV2_basic(t *testing.T) {
+	var gw VirtualGateway
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDC(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDCVirtualGatewayV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDCVirtualGatewayV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDCVirtualGatewayV2Exists("opentelekomcloud_dc_virtual_gateway_v2.vgw_1", &gw),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_dc_virtual_gateway_v2.vgw_1", "vpc_id",
+						"opentelekomcloud_vpc_v1.vpc_1", "id"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_gateway_v2.vgw_1", "local_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_gateway_v2.vgw_1", "status", "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDCVirtualGatewayV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDCVirtualGatewayV2Exists("opentelekomcloud_dc_virtual_gateway_v2.vgw_1", &gw),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_gateway_v2.vgw_1", "name", "vgw_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_gateway_v2.vgw_1", "local_cidrs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDCVirtualGatewayV2_timeout(t *testing.T) {
+	var gw VirtualGateway
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDC(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDCVirtualGatewayV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDCVirtualGatewayV2_timeout,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDCVirtualGatewayV2Exists("opentelekomcloud_dc_virtual_gateway_v2.vgw_1", &gw),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDCVirtualGatewayV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dcClient, err := config.hwNetworkV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dc_virtual_gateway_v2" {
+			continue
+		}
+
+		_, err := dcV2GetVirtualGateway(dcClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Virtual gateway still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDCVirtualGatewayV2Exists(n string, gw *VirtualGateway) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dcClient, err := config.hwNetworkV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+		}
+
+		found, err := dcV2GetVirtualGateway(dcClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Virtual gateway not found")
+		}
+
+		*gw = *found
+
+		return nil
+	}
+}
+
+const testAccDCVirtualGatewayV2_basic = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_dc"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_dc_virtual_gateway_v2" "vgw_1" {
+  name        = "vgw_basic"
+  vpc_id      = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  local_cidrs = ["${opentelekomcloud_vpc_v1.vpc_1.cidr}"]
+}
+`
+
+const testAccDCVirtualGatewayV2_update = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_dc"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_dc_virtual_gateway_v2" "vgw_1" {
+  name        = "vgw_updated"
+  description = "updated"
+  vpc_id      = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  local_cidrs = ["192.168.0.0/24", "192.168.1.0/24"]
+}
+`
+
+const testAccDCVirtualGatewayV2_timeout = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_dc"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_dc_virtual_gateway_v2" "vgw_1" {
+  name        = "vgw_timeout"
+  vpc_id      = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  local_cidrs = ["${opentelekomcloud_vpc_v1.vpc_1.cidr}"]
+
+  timeouts {
+    create = "5m"
+    delete = "5m"
+  }
+}
+`
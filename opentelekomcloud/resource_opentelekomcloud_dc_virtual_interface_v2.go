@@ -0,0 +1,302 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDCVirtualInterfaceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDCVirtualInterfaceV2Create,
+		Read:   resourceDCVirtualInterfaceV2Read,
+		Update: resourceDCVirtualInterfaceV2Update,
+		Delete: resourceDCVirtualInterfaceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateName,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"direct_connect_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"virtual_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vlan": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"bandwidth": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"local_gateway_v4_ip": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPWithMask,
+			},
+			"remote_gateway_v4_ip": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPWithMask,
+			},
+			"remote_cidrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDR,
+				},
+			},
+			"route_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "static",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"static", "bgp"})
+				},
+			},
+			"bgp_asn": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"bgp_md5": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"remote_endpoint_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDCVirtualInterfaceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	routeMode := d.Get("route_mode").(string)
+	if routeMode == "bgp" && d.Get("bgp_asn").(int) == 0 {
+		return fmt.Errorf("bgp_asn must be set when route_mode is bgp")
+	}
+
+	name := d.Get("name").(string)
+	groupID, err := dcV2CreateCIDREndpointGroup(dcClient, name, d.Get("remote_cidrs").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	createOpts := VirtualInterfaceCreateOpts{
+		TenantID:          d.Get("tenant_id").(string),
+		Name:              name,
+		Description:       d.Get("description").(string),
+		DirectConnectID:   d.Get("direct_connect_id").(string),
+		VgwID:             d.Get("virtual_gateway_id").(string),
+		Type:              "private",
+		ServiceType:       "vpc",
+		VLAN:              d.Get("vlan").(int),
+		Bandwidth:         d.Get("bandwidth").(int),
+		LocalGatewayV4IP:  d.Get("local_gateway_v4_ip").(string),
+		RemoteGatewayV4IP: d.Get("remote_gateway_v4_ip").(string),
+		RouteMode:         routeMode,
+		BGPASN:            d.Get("bgp_asn").(int),
+		BGPMD5:            d.Get("bgp_md5").(string),
+		RemoteEPGroupID:   groupID,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	vif, err := dcV2CreateVirtualInterface(dcClient, createOpts)
+	if err != nil {
+		if delErr := dcV2DeleteEndpointGroup(dcClient, groupID); delErr != nil {
+			log.Printf("[WARN] Error cleaning up Direct Connect endpoint group %s: %s", groupID, delErr)
+		}
+		return fmt.Errorf("Error creating OpenTelekomCloud virtual interface: %s", err)
+	}
+
+	d.SetId(vif.ID)
+	log.Printf("[INFO] Virtual interface ID: %s", vif.ID)
+
+	log.Printf("[DEBUG] Waiting for virtual interface (%s) to become available", vif.ID)
+	err = waitForDCV2Status(resourceDCVirtualInterfaceV2RefreshFunc(dcClient, vif.ID),
+		[]string{"PENDING_CREATE", "BUILD"}, "ACTIVE", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for virtual interface (%s) to become ACTIVE: %s", vif.ID, err)
+	}
+
+	return resourceDCVirtualInterfaceV2Read(d, meta)
+}
+
+func resourceDCVirtualInterfaceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	vif, err := dcV2GetVirtualInterface(dcClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "virtual interface")
+	}
+
+	log.Printf("[DEBUG] Retrieved virtual interface %s: %#v", d.Id(), vif)
+
+	d.Set("name", vif.Name)
+	d.Set("description", vif.Description)
+	d.Set("direct_connect_id", vif.DirectConnectID)
+	d.Set("virtual_gateway_id", vif.VgwID)
+	d.Set("vlan", vif.VLAN)
+	d.Set("bandwidth", vif.Bandwidth)
+	d.Set("local_gateway_v4_ip", vif.LocalGatewayV4IP)
+	d.Set("remote_gateway_v4_ip", vif.RemoteGatewayV4IP)
+	d.Set("route_mode", vif.RouteMode)
+	d.Set("bgp_asn", vif.BGPASN)
+	d.Set("tenant_id", vif.TenantID)
+	d.Set("remote_endpoint_group_id", vif.RemoteEPGroupID)
+	d.Set("status", vif.Status)
+	d.Set("region", GetRegion(d, config))
+
+	if vif.RemoteEPGroupID != "" {
+		group, err := dcV2GetEndpointGroup(dcClient, vif.RemoteEPGroupID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving remote endpoint group of virtual interface %s: %s", d.Id(), err)
+		}
+		d.Set("remote_cidrs", group.Endpoints)
+	}
+
+	return nil
+}
+
+func resourceDCVirtualInterfaceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	var updateOpts VirtualInterfaceUpdateOpts
+	var groupID, oldGroupID string
+
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		updateOpts.Description = d.Get("description").(string)
+	}
+	if d.HasChange("bandwidth") {
+		updateOpts.Bandwidth = d.Get("bandwidth").(int)
+	}
+	if d.HasChange("remote_cidrs") {
+		groupID, err = dcV2CreateCIDREndpointGroup(dcClient, d.Get("name").(string), d.Get("remote_cidrs").([]interface{}))
+		if err != nil {
+			return err
+		}
+		updateOpts.RemoteEPGroupID = groupID
+		oldGroupID = d.Get("remote_endpoint_group_id").(string)
+	}
+
+	log.Printf("[DEBUG] Updating virtual interface %s with options: %#v", d.Id(), updateOpts)
+	err = dcV2UpdateVirtualInterface(dcClient, d.Id(), updateOpts)
+	if err != nil {
+		if groupID != "" {
+			if delErr := dcV2DeleteEndpointGroup(dcClient, groupID); delErr != nil {
+				log.Printf("[WARN] Error cleaning up Direct Connect endpoint group %s: %s", groupID, delErr)
+			}
+		}
+		return fmt.Errorf("Error updating OpenTelekomCloud virtual interface: %s", err)
+	}
+
+	if oldGroupID != "" {
+		if err := dcV2DeleteEndpointGroup(dcClient, oldGroupID); err != nil {
+			log.Printf("[WARN] Error deleting Direct Connect endpoint group %s: %s", oldGroupID, err)
+		}
+	}
+
+	return resourceDCVirtualInterfaceV2Read(d, meta)
+}
+
+func resourceDCVirtualInterfaceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dcClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	groupID := d.Get("remote_endpoint_group_id").(string)
+
+	err = dcV2DeleteVirtualInterface(dcClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "virtual interface")
+	}
+
+	err = waitForDCV2Status(resourceDCVirtualInterfaceV2RefreshFunc(dcClient, d.Id()),
+		[]string{"ACTIVE", "DOWN", "PENDING_DELETE"}, "DELETED", d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("Error waiting for virtual interface (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	if groupID != "" {
+		err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+			err := dcV2DeleteEndpointGroup(dcClient, groupID)
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil && !isResourceNotFound(err) {
+			return fmt.Errorf("Error deleting Direct Connect endpoint group %s: %s", groupID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
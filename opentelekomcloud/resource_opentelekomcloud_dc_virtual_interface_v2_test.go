@@ -0,0 +1,142 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDCVirtualInterfaceV2_basic(t *testing.T) {
+	var vif VirtualInterface
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDC(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDCVirtualInterfaceV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDCVirtualInterfaceV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDCVirtualInterfaceV2Exists("opentelekomcloud_dc_virtual_interface_v2.vif_1", &vif),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_dc_virtual_interface_v2.vif_1", "virtual_gateway_id",
+						"opentelekomcloud_dc_virtual_gateway_v2.vgw_1", "id"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_interface_v2.vif_1", "route_mode", "static"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_interface_v2.vif_1", "bandwidth", "10"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDCVirtualInterfaceV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDCVirtualInterfaceV2Exists("opentelekomcloud_dc_virtual_interface_v2.vif_1", &vif),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_interface_v2.vif_1", "name", "vif_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_interface_v2.vif_1", "bandwidth", "20"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dc_virtual_interface_v2.vif_1", "remote_cidrs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDCVirtualInterfaceV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dcClient, err := config.hwNetworkV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dc_virtual_interface_v2" {
+			continue
+		}
+
+		_, err := dcV2GetVirtualInterface(dcClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Virtual interface still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDCVirtualInterfaceV2Exists(n string, vif *VirtualInterface) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dcClient, err := config.hwNetworkV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud direct connect client: %s", err)
+		}
+
+		found, err := dcV2GetVirtualInterface(dcClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Virtual interface not found")
+		}
+
+		*vif = *found
+
+		return nil
+	}
+}
+
+const testAccDCVirtualInterfaceV2_gateway = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_dc"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_dc_virtual_gateway_v2" "vgw_1" {
+  name        = "vgw_vif"
+  vpc_id      = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  local_cidrs = ["${opentelekomcloud_vpc_v1.vpc_1.cidr}"]
+}
+`
+
+var testAccDCVirtualInterfaceV2_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dc_virtual_interface_v2" "vif_1" {
+  name                 = "vif_basic"
+  direct_connect_id    = "%s"
+  virtual_gateway_id   = "${opentelekomcloud_dc_virtual_gateway_v2.vgw_1.id}"
+  vlan                 = 100
+  bandwidth            = 10
+  local_gateway_v4_ip  = "10.0.0.1/30"
+  remote_gateway_v4_ip = "10.0.0.2/30"
+  remote_cidrs         = ["172.16.0.0/16"]
+}
+`, testAccDCVirtualInterfaceV2_gateway, OS_DC_CONNECTION_ID)
+
+var testAccDCVirtualInterfaceV2_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dc_virtual_interface_v2" "vif_1" {
+  name                 = "vif_updated"
+  direct_connect_id    = "%s"
+  virtual_gateway_id   = "${opentelekomcloud_dc_virtual_gateway_v2.vgw_1.id}"
+  vlan                 = 100
+  bandwidth            = 20
+  local_gateway_v4_ip  = "10.0.0.1/30"
+  remote_gateway_v4_ip = "10.0.0.2/30"
+  remote_cidrs         = ["172.16.0.0/16", "172.17.0.0/16"]
+}
+`, testAccDCVirtualInterfaceV2_gateway, OS_DC_CONNECTION_ID)
@@ -0,0 +1,264 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+)
+
+func resourceDehHostV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDehHostV1Create,
+		Read:   resourceDehHostV1Read,
+		Update: resourceDehHostV1Update,
+		Delete: resourceDehHostV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: resourceDehHostV1ComputedSchema(map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateName,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"auto_placement": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "on",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"on", "off"})
+				},
+			},
+		}),
+	}
+}
+
+// resourceDehHostV1ComputedSchema adds the attributes describing the state
+// and capacity of a dedicated host to s. It is shared by the resource and the
+// data source.
+func resourceDehHostV1ComputedSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	computed := map[string]schema.ValueType{
+		"state":            schema.TypeString,
+		"host_type_name":   schema.TypeString,
+		"vcpus":            schema.TypeInt,
+		"cores":            schema.TypeInt,
+		"sockets":          schema.TypeInt,
+		"memory":           schema.TypeInt,
+		"available_vcpus":  schema.TypeInt,
+		"available_memory": schema.TypeInt,
+		"instance_total":   schema.TypeInt,
+	}
+	for k, t := range computed {
+		if _, ok := s[k]; !ok {
+			s[k] = &schema.Schema{
+				Type:     t,
+				Computed: true,
+			}
+		}
+	}
+	s["instance_uuids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["available_flavors"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	return s
+}
+
+func resourceDehHostV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.dehV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DeH client: %s", err)
+	}
+
+	createOpts := DedicatedHostCreateOpts{
+		Name:             d.Get("name").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		HostType:         d.Get("host_type").(string),
+		AutoPlacement:    d.Get("auto_placement").(string),
+		Quantity:         1,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	ids, err := dehV1AllocateHosts(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error allocating OpenTelekomCloud dedicated host: %s", err)
+	}
+	if len(ids) != 1 {
+		return fmt.Errorf("Error allocating OpenTelekomCloud dedicated host: unexpected host IDs %v", ids)
+	}
+
+	d.SetId(ids[0])
+	log.Printf("[INFO] Dedicated host ID: %s", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    waitForDehHostV1State(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for dedicated host (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceDehHostV1Read(d, meta)
+}
+
+func resourceDehHostV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.dehV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DeH client: %s", err)
+	}
+
+	host, err := dehV1GetHost(client, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "dedicated host")
+	}
+
+	log.Printf("[DEBUG] Retrieved dedicated host %s: %#v", d.Id(), host)
+
+	if host.State == "released" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", host.Name)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_type", host.HostProperties.HostType)
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("region", GetRegion(d, config))
+
+	return setDehHostV1Attributes(d, host)
+}
+
+func setDehHostV1Attributes(d *schema.ResourceData, host *DedicatedHost) error {
+	d.Set("state", host.State)
+	d.Set("host_type_name", host.HostProperties.HostTypeName)
+	d.Set("vcpus", host.HostProperties.Vcpus)
+	d.Set("cores", host.HostProperties.Cores)
+	d.Set("sockets", host.HostProperties.Sockets)
+	d.Set("memory", host.HostProperties.Memory)
+	d.Set("available_vcpus", host.AvailableVcpus)
+	d.Set("available_memory", host.AvailableMemory)
+	d.Set("instance_total", host.InstanceTotal)
+	if err := d.Set("instance_uuids", host.InstanceUUIDs); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving instance_uuids to state for OpenTelekomCloud dedicated host (%s): %s", host.ID, err)
+	}
+
+	flavors := make([]string, 0, len(host.HostProperties.AvailableInstanceCapacities))
+	for _, c := range host.HostProperties.AvailableInstanceCapacities {
+		flavors = append(flavors, c.Flavor)
+	}
+	if err := d.Set("available_flavors", flavors); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving available_flavors to state for OpenTelekomCloud dedicated host (%s): %s", host.ID, err)
+	}
+
+	return nil
+}
+
+func resourceDehHostV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.dehV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DeH client: %s", err)
+	}
+
+	var updateOpts DedicatedHostUpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("auto_placement") {
+		updateOpts.AutoPlacement = d.Get("auto_placement").(string)
+	}
+
+	if updateOpts != (DedicatedHostUpdateOpts{}) {
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		if err := dehV1UpdateHost(client, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud dedicated host (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDehHostV1Read(d, meta)
+}
+
+func resourceDehHostV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.dehV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DeH client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Releasing dedicated host %s", d.Id())
+	if err := dehV1ReleaseHost(client, d.Id()); err != nil {
+		return CheckDeleted(d, err, "dedicated host")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "fault"},
+		Target:     []string{"released"},
+		Refresh:    waitForDehHostV1State(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for dedicated host (%s) to be released: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForDehHostV1State(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		host, err := dehV1GetHost(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return host, "released", nil
+			}
+			return nil, "", err
+		}
+
+		// A host being allocated has no state yet
+		if host.State == "" {
+			return host, "creating", nil
+		}
+		return host, host.State, nil
+	}
+}
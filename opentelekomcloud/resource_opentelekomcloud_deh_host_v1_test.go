@@ -0,0 +1,169 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDehHostV1_basic(t *testing.T) {
+	var host DedicatedHost
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDeh(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDehHostV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDehHostV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDehHostV1Exists("opentelekomcloud_deh_host_v1.deh_1", &host),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_deh_host_v1.deh_1", "name", "deh_1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_deh_host_v1.deh_1", "auto_placement", "on"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_deh_host_v1.deh_1", "state", "available"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDehHostV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDehHostV1Exists("opentelekomcloud_deh_host_v1.deh_1", &host),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_deh_host_v1.deh_1", "name", "deh_1_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_deh_host_v1.deh_1", "auto_placement", "off"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDehHostV1_instance(t *testing.T) {
+	var host DedicatedHost
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDeh(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDehHostV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDehHostV1_instance,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDehHostV1Exists("opentelekomcloud_deh_host_v1.deh_1", &host),
+					testAccCheckDehHostV1Instance(&host, "opentelekomcloud_compute_instance_v2.instance_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDehHostV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.dehV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DeH client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_deh_host_v1" {
+			continue
+		}
+
+		host, err := dehV1GetHost(client, rs.Primary.ID)
+		if err == nil && host.State != "released" {
+			return fmt.Errorf("Dedicated host still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDehHostV1Exists(n string, host *DedicatedHost) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.dehV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud DeH client: %s", err)
+		}
+
+		found, err := dehV1GetHost(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Dedicated host not found")
+		}
+
+		*host = *found
+
+		return nil
+	}
+}
+
+func testAccCheckDehHostV1Instance(host *DedicatedHost, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for _, id := range host.InstanceUUIDs {
+			if id == rs.Primary.ID {
+				return nil
+			}
+		}
+		return fmt.Errorf("Instance %s is not placed on dedicated host %s", rs.Primary.ID, host.ID)
+	}
+}
+
+var testAccDehHostV1_basic = fmt.Sprintf(`
+resource "opentelekomcloud_deh_host_v1" "deh_1" {
+  name = "deh_1"
+  availability_zone = "%s"
+  host_type = "%s"
+}
+`, OS_AVAILABILITY_ZONE, OS_DEH_HOST_TYPE)
+
+var testAccDehHostV1_update = fmt.Sprintf(`
+resource "opentelekomcloud_deh_host_v1" "deh_1" {
+  name = "deh_1_updated"
+  availability_zone = "%s"
+  host_type = "%s"
+  auto_placement = "off"
+}
+`, OS_AVAILABILITY_ZONE, OS_DEH_HOST_TYPE)
+
+var testAccDehHostV1_instance = fmt.Sprintf(`
+resource "opentelekomcloud_deh_host_v1" "deh_1" {
+  name = "deh_1"
+  availability_zone = "%s"
+  host_type = "%s"
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  availability_zone = "%s"
+  flavor_name = "${element(opentelekomcloud_deh_host_v1.deh_1.available_flavors, 0)}"
+  network {
+    uuid = "%s"
+  }
+  scheduler_hints {
+    dedicated_host_id = "${opentelekomcloud_deh_host_v1.deh_1.id}"
+  }
+}
+`, OS_AVAILABILITY_ZONE, OS_DEH_HOST_TYPE, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
@@ -0,0 +1,531 @@
+package opentelekomcloud
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+)
+
+func resourceEcsInstanceV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEcsInstanceV1Create,
+		Read:   resourceEcsInstanceV1Read,
+		Update: resourceEcsInstanceV1Update,
+		Delete: resourceEcsInstanceV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nics": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ip_address": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateIP,
+						},
+						"mac_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"system_disk_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "SATA",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"SATA", "SAS", "SSD", "co-p1", "uh-l1"})
+				},
+			},
+			"system_disk_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"system_disk_kms_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"data_disks": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 23,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"SATA", "SAS", "SSD", "co-p1", "uh-l1"})
+							},
+						},
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"kms_key_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"security_groups": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"user_data": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				// just stash the hash for state & diff comparisons
+				StateFunc: func(v interface{}) string {
+					switch v.(type) {
+					case string:
+						hash := sha1.Sum([]byte(v.(string)))
+						return hex.EncodeToString(hash[:])
+					default:
+						return ""
+					}
+				},
+			},
+			"eip_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"eip_type"},
+			},
+			"eip_type": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"eip_id"},
+			},
+			"bandwidth": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"share_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "PER",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"PER", "WHOLE"})
+							},
+						},
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"charge_mode": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "traffic",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"traffic", "bandwidth"})
+							},
+						},
+					},
+				},
+			},
+			"delete_disks_on_termination": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags": tagsSchema(),
+			"public_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceEcsInstanceV1Volume builds a system or data disk, the disk is
+// encrypted with the given KMS key when kmsKeyID is set.
+func resourceEcsInstanceV1Volume(volumeType string, size int, kmsKeyID string) CloudServerVolume {
+	volume := CloudServerVolume{
+		VolumeType: volumeType,
+		Size:       size,
+	}
+	if kmsKeyID != "" {
+		volume.Metadata = map[string]string{
+			"__system__encrypted": "1",
+			"__system__cmkid":     kmsKeyID,
+		}
+	}
+	return volume
+}
+
+func resourceEcsInstanceV1DataVolumes(d *schema.ResourceData) []CloudServerVolume {
+	var volumes []CloudServerVolume
+	for _, raw := range d.Get("data_disks").([]interface{}) {
+		disk := raw.(map[string]interface{})
+		volumes = append(volumes, resourceEcsInstanceV1Volume(
+			disk["type"].(string), disk["size"].(int), disk["kms_key_id"].(string)))
+	}
+	return volumes
+}
+
+func resourceEcsInstanceV1Nics(d *schema.ResourceData) []CloudServerNic {
+	var nics []CloudServerNic
+	for _, raw := range d.Get("nics").([]interface{}) {
+		nic := raw.(map[string]interface{})
+		nics = append(nics, CloudServerNic{
+			SubnetID:  nic["network_id"].(string),
+			IPAddress: nic["ip_address"].(string),
+		})
+	}
+	return nics
+}
+
+func resourceEcsInstanceV1SecurityGroups(d *schema.ResourceData) []CloudServerSecurityGroup {
+	var groups []CloudServerSecurityGroup
+	for _, raw := range d.Get("security_groups").(*schema.Set).List() {
+		groups = append(groups, CloudServerSecurityGroup{ID: raw.(string)})
+	}
+	return groups
+}
+
+func resourceEcsInstanceV1PublicIP(d *schema.ResourceData) *CloudServerPublicIP {
+	if v, ok := d.GetOk("eip_id"); ok {
+		return &CloudServerPublicIP{ID: v.(string)}
+	}
+
+	v, ok := d.GetOk("eip_type")
+	if !ok {
+		return nil
+	}
+	eip := &CloudServerEIP{
+		IPType: v.(string),
+	}
+	if raw := d.Get("bandwidth").([]interface{}); len(raw) > 0 {
+		bw := raw[0].(map[string]interface{})
+		eip.BandWidth = CloudServerBandWidth{
+			ShareType:  bw["share_type"].(string),
+			Size:       bw["size"].(int),
+			ChargeMode: bw["charge_mode"].(string),
+		}
+	}
+	return &CloudServerPublicIP{EIP: eip}
+}
+
+func resourceEcsInstanceV1Tags(d *schema.ResourceData) []VpcTag {
+	_, tags := diffVpcTags(nil, d.Get("tags").(map[string]interface{}))
+	return tags
+}
+
+func resourceEcsInstanceV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ecsClient, err := chooseECSV1Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ECS client: %s", err)
+	}
+
+	if _, ok := d.GetOk("eip_type"); ok {
+		if len(d.Get("bandwidth").([]interface{})) == 0 {
+			return fmt.Errorf("bandwidth must be set when eip_type is set")
+		}
+	}
+
+	createOpts := CloudServerCreateOpts{
+		Name:             d.Get("name").(string),
+		ImageRef:         d.Get("image_id").(string),
+		FlavorRef:        d.Get("flavor").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		VpcID:            d.Get("vpc_id").(string),
+		Nics:             resourceEcsInstanceV1Nics(d),
+		RootVolume: resourceEcsInstanceV1Volume(d.Get("system_disk_type").(string),
+			d.Get("system_disk_size").(int), d.Get("system_disk_kms_key_id").(string)),
+		DataVolumes:    resourceEcsInstanceV1DataVolumes(d),
+		SecurityGroups: resourceEcsInstanceV1SecurityGroups(d),
+		PublicIP:       resourceEcsInstanceV1PublicIP(d),
+		KeyName:        d.Get("key_name").(string),
+		UserData:       []byte(d.Get("user_data").(string)),
+		ServerTags:     resourceEcsInstanceV1Tags(d),
+		Count:          1,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	// Add password here so it wouldn't go in the above log entry
+	createOpts.AdminPass = d.Get("password").(string)
+
+	jobID, err := ecsV1CreateServer(ecsClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ECS instance: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for ECS job (%s) to create the instance", jobID)
+	job, err := waitForECSV1Job(ecsClient, jobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for ECS instance to be created: %s", err)
+	}
+	if len(job.Entities.SubJobs) == 0 || job.Entities.SubJobs[0].Entities.ServerID == "" {
+		return fmt.Errorf("ECS job %s did not return the ID of the created instance", jobID)
+	}
+
+	id := job.Entities.SubJobs[0].Entities.ServerID
+	d.SetId(id)
+	log.Printf("[INFO] ECS instance ID: %s", id)
+
+	return resourceEcsInstanceV1Read(d, meta)
+}
+
+func resourceEcsInstanceV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ecsClient, err := chooseECSV1Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ECS client: %s", err)
+	}
+
+	server, err := ecsV1GetServer(ecsClient, d.Id())
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving OpenTelekomCloud ECS instance: %s", err)
+	}
+	if server.Status == "DELETED" {
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Retrieved ECS instance %s: %#v", d.Id(), server)
+
+	d.Set("name", server.Name)
+	d.Set("image_id", server.Image.ID)
+	d.Set("flavor", server.Flavor.ID)
+	d.Set("availability_zone", server.AvailabilityZone)
+	d.Set("key_name", server.KeyName)
+	d.Set("status", server.Status)
+	d.Set("region", GetRegion(d, config))
+	if vpcID, ok := server.Metadata["vpc_id"]; ok {
+		d.Set("vpc_id", vpcID)
+	}
+
+	var groups []string
+	for _, group := range server.SecurityGroups {
+		if group.ID != "" {
+			groups = append(groups, group.ID)
+		}
+	}
+	if len(groups) > 0 {
+		d.Set("security_groups", groups)
+	}
+
+	d.Set("public_ip", "")
+	for _, addresses := range server.Addresses {
+		for _, address := range addresses {
+			if address.Type == "floating" && address.Version == 4 {
+				d.Set("public_ip", address.Addr)
+			}
+		}
+	}
+
+	interfaces, err := ecsV1ListInterfaces(ecsClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving NICs of OpenTelekomCloud ECS instance %s: %s", d.Id(), err)
+	}
+	if err := d.Set("nics", resourceEcsInstanceV1FlattenNics(d, interfaces)); err != nil {
+		return fmt.Errorf("Error setting nics of OpenTelekomCloud ECS instance %s: %s", d.Id(), err)
+	}
+
+	tags, err := ecsV1GetTags(ecsClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving tags of OpenTelekomCloud ECS instance %s: %s", d.Id(), err)
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+// resourceEcsInstanceV1FlattenNics returns the NICs in the order of the
+// configuration, NICs which are not configured (e.g. after an import) are
+// appended in the order returned by the API.
+func resourceEcsInstanceV1FlattenNics(d *schema.ResourceData, interfaces []CloudServerInterface) []map[string]interface{} {
+	flatten := func(iface CloudServerInterface) map[string]interface{} {
+		nic := map[string]interface{}{
+			"network_id":  iface.NetID,
+			"mac_address": iface.MacAddr,
+			"port_id":     iface.PortID,
+		}
+		if len(iface.FixedIPs) > 0 {
+			nic["ip_address"] = iface.FixedIPs[0].IPAddress
+		}
+		return nic
+	}
+
+	used := make([]bool, len(interfaces))
+	var nics []map[string]interface{}
+	for _, raw := range d.Get("nics").([]interface{}) {
+		nic := raw.(map[string]interface{})
+		for i, iface := range interfaces {
+			if used[i] || iface.NetID != nic["network_id"].(string) {
+				continue
+			}
+			if ip := nic["ip_address"].(string); ip != "" &&
+				(len(iface.FixedIPs) == 0 || iface.FixedIPs[0].IPAddress != ip) {
+				continue
+			}
+			used[i] = true
+			nics = append(nics, flatten(iface))
+			break
+		}
+	}
+	for i, iface := range interfaces {
+		if !used[i] {
+			nics = append(nics, flatten(iface))
+		}
+	}
+	return nics
+}
+
+func resourceEcsInstanceV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ecsClient, err := chooseECSV1Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ECS client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		err = ecsV1UpdateServerName(ecsClient, d.Id(), d.Get("name").(string))
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud ECS instance: %s", err)
+		}
+	}
+
+	if err := ecsV1UpdateTags(ecsClient, d, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceEcsInstanceV1Read(d, meta)
+}
+
+func resourceEcsInstanceV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ecsClient, err := chooseECSV1Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ECS client: %s", err)
+	}
+
+	_, allocatedEIP := d.GetOk("eip_type")
+	deleteOpts := CloudServerDeleteOpts{
+		Servers:        []CloudServerRef{{ID: d.Id()}},
+		DeletePublicIP: allocatedEIP,
+		DeleteVolume:   d.Get("delete_disks_on_termination").(bool),
+	}
+
+	log.Printf("[DEBUG] Delete Options: %#v", deleteOpts)
+	var jobID string
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		jobID, err = ecsV1DeleteServers(ecsClient, deleteOpts)
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return CheckDeleted(d, err, "ECS instance")
+	}
+
+	log.Printf("[DEBUG] Waiting for ECS job (%s) to delete the instance", jobID)
+	_, err = waitForECSV1Job(ecsClient, jobID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("Error waiting for ECS instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,206 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccEcsV1Instance_basic(t *testing.T) {
+	var instance CloudServer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEcsV1Instance_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists("opentelekomcloud_ecs_instance_v1.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "name", "ecs_instance_1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "nics.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "nics.0.ip_address"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "tags.foo", "bar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEcsV1Instance_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists("opentelekomcloud_ecs_instance_v1.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "name", "ecs_instance_1_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "tags.key", "value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEcsV1Instance_disksEIP(t *testing.T) {
+	var instance CloudServer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEcsV1Instance_disksEIP,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists("opentelekomcloud_ecs_instance_v1.instance_1", &instance),
+					testAccCheckEcsV1InstanceVolumes(&instance, 2),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_ecs_instance_v1.instance_1", "public_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEcsV1InstanceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	ecsClient, err := config.loadECSV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ECS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ecs_instance_v1" {
+			continue
+		}
+
+		server, err := ecsV1GetServer(ecsClient, rs.Primary.ID)
+		if err == nil && server.Status != "DELETED" {
+			return fmt.Errorf("Instance still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEcsV1InstanceExists(n string, instance *CloudServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		ecsClient, err := config.loadECSV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud ECS client: %s", err)
+		}
+
+		found, err := ecsV1GetServer(ecsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Instance not found")
+		}
+
+		*instance = *found
+
+		return nil
+	}
+}
+
+func testAccCheckEcsV1InstanceVolumes(instance *CloudServer, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(instance.VolumesAttached) != expected {
+			return fmt.Errorf("Expected %d volumes attached, got %d", expected, len(instance.VolumesAttached))
+		}
+
+		return nil
+	}
+}
+
+var testAccEcsV1Instance_basic = fmt.Sprintf(`
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name = "ecs_instance_1"
+  image_id = "%s"
+  flavor = "%s"
+  vpc_id = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  availability_zone = "%s"
+
+  tags {
+    foo = "bar"
+  }
+}
+`, OS_IMAGE_ID, OS_FLAVOR_ID, OS_VPC_ID, OS_NETWORK_ID, OS_AVAILABILITY_ZONE)
+
+var testAccEcsV1Instance_update = fmt.Sprintf(`
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name = "ecs_instance_1_updated"
+  image_id = "%s"
+  flavor = "%s"
+  vpc_id = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  availability_zone = "%s"
+
+  tags {
+    key = "value"
+  }
+}
+`, OS_IMAGE_ID, OS_FLAVOR_ID, OS_VPC_ID, OS_NETWORK_ID, OS_AVAILABILITY_ZONE)
+
+var testAccEcsV1Instance_disksEIP = fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias = "ecs_instance_key_1"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name = "ecs_instance_1"
+  image_id = "%s"
+  flavor = "%s"
+  vpc_id = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  system_disk_type = "SAS"
+  system_disk_size = 60
+
+  data_disks {
+    type = "SATA"
+    size = 20
+    kms_key_id = "${opentelekomcloud_kms_key_v1.key_1.id}"
+  }
+
+  eip_type = "5_bgp"
+  bandwidth {
+    size = 5
+  }
+
+  availability_zone = "%s"
+  delete_disks_on_termination = true
+}
+`, OS_IMAGE_ID, OS_FLAVOR_ID, OS_VPC_ID, OS_NETWORK_ID, OS_AVAILABILITY_ZONE)
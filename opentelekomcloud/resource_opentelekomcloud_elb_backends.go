@@ -0,0 +1,228 @@
+package opentelekomcloud
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/elbaas/backendmember"
+)
+
+// resourceBackends manages all backends of a classic ELB listener. Its ID is
+// the ID of the listener.
+func resourceBackends() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBackendsCreate,
+		Read:   resourceBackendsRead,
+		Update: resourceBackendsUpdate,
+		Delete: resourceBackendsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"backend": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceBackendsBackendHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"server_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBackendsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	listenerID := d.Get("listener_id").(string)
+	backends := d.Get("backend").(*schema.Set).List()
+
+	// Set the ID first, so backends added before a failure are tracked and
+	// reconciled by the next refresh.
+	d.SetId(listenerID)
+
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	if err := resourceBackendsApply(client, listenerID, nil, backends, deadline); err != nil {
+		return err
+	}
+
+	return resourceBackendsRead(d, meta)
+}
+
+func resourceBackendsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	allBackends, err := elbListBackends(client, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "backend members")
+	}
+
+	log.Printf("[DEBUG] Retrieved backend members of listener %s: %#v", d.Id(), allBackends)
+
+	backends := make([]map[string]interface{}, len(allBackends))
+	for i, b := range allBackends {
+		backends[i] = map[string]interface{}{
+			"id":        b.ID,
+			"server_id": b.ServerID,
+			"address":   b.ServerAddress,
+		}
+	}
+
+	d.Set("listener_id", d.Id())
+	if err := d.Set("backend", backends); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving backend to state for listener (%s): %s", d.Id(), err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBackendsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if d.HasChange("backend") {
+		o, n := d.GetChange("backend")
+		oldBackends := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		newBackends := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+		if err := resourceBackendsApply(client, d.Id(), oldBackends, newBackends, deadline); err != nil {
+			return err
+		}
+	}
+
+	return resourceBackendsRead(d, meta)
+}
+
+func resourceBackendsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	backends := d.Get("backend").(*schema.Set).List()
+
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	err = resourceBackendsApply(client, d.Id(), backends, nil, deadline)
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[DEBUG] Listener %s of the backend members has already been deleted", d.Id())
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// resourceBackendsApply removes the backends oldBackends from and adds the
+// backends newBackends to a listener, with one job for all removals and one
+// job for all additions. Both jobs share the deadline of the operation.
+func resourceBackendsApply(client *golangsdk.ServiceClient, listenerID string, oldBackends, newBackends []interface{}, deadline time.Time) error {
+	var ids []string
+	for _, raw := range oldBackends {
+		if id := raw.(map[string]interface{})["id"].(string); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) > 0 {
+		log.Printf("[DEBUG] Removing backend members %v from listener %s", ids, listenerID)
+		job, err := elbRemoveBackends(client, listenerID, ids)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("Waiting for backend members of listener %s to delete", listenerID)
+		if err := golangsdk.WaitForJobSuccess(client, job.URI, int(time.Until(deadline)/time.Second)); err != nil {
+			return err
+		}
+	}
+
+	var addOpts []backendmember.AddOpts
+	for _, raw := range newBackends {
+		backend := raw.(map[string]interface{})
+		addOpts = append(addOpts, backendmember.AddOpts{
+			ServerId: backend["server_id"].(string),
+			Address:  backend["address"].(string),
+		})
+	}
+
+	if len(addOpts) > 0 {
+		log.Printf("[DEBUG] Create Options: %#v", addOpts)
+		job, err := elbAddBackends(client, listenerID, addOpts)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("Waiting for backend members of listener %s to become active", listenerID)
+		if err := golangsdk.WaitForJobSuccess(client, job.URI, int(time.Until(deadline)/time.Second)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceBackendsBackendHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["server_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,193 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccELBBackends_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckELBBackendsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccELBBackendsConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckELBBackendsExists("opentelekomcloud_elb_backends.backends_1", 1),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_elb_backends.backends_1", "backend.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccELBBackendsConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckELBBackendsExists("opentelekomcloud_elb_backends.backends_1", 2),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_elb_backends.backends_1", "backend.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckELBBackendsDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.loadELBClient(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_elb_backends" {
+			continue
+		}
+
+		backends, err := elbListBackends(client, rs.Primary.ID)
+		if err == nil && len(backends) > 0 {
+			return fmt.Errorf("Backend members of listener %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckELBBackendsExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.loadELBClient(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		backends, err := elbListBackends(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(backends) != count {
+			return fmt.Errorf("Expected %d backend members, got %d", count, len(backends))
+		}
+
+		return nil
+	}
+}
+
+var TestAccELBBackendsConfig_basic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm_1" {
+  name = "instance_1"
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_compute_instance_v2" "vm_2" {
+  name = "instance_2"
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_elb_loadbalancer" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vpc_id = "%s"
+  type = "External"
+  bandwidth = 5
+  admin_state_up = true
+}
+
+resource "opentelekomcloud_elb_listener" "listener_1" {
+  name = "listener_1"
+  protocol = "TCP"
+  protocol_port = 8080
+  backend_protocol = "TCP"
+  backend_port = 8080
+  lb_algorithm = "roundrobin"
+  loadbalancer_id = "${opentelekomcloud_elb_loadbalancer.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_elb_backends" "backends_1" {
+  listener_id = "${opentelekomcloud_elb_listener.listener_1.id}"
+
+  backend {
+    server_id = "${opentelekomcloud_compute_instance_v2.vm_1.id}"
+    address = "${opentelekomcloud_compute_instance_v2.vm_1.network.0.fixed_ip_v4}"
+  }
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID)
+
+var TestAccELBBackendsConfig_update = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm_1" {
+  name = "instance_1"
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_compute_instance_v2" "vm_2" {
+  name = "instance_2"
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_elb_loadbalancer" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vpc_id = "%s"
+  type = "External"
+  bandwidth = 5
+  admin_state_up = true
+}
+
+resource "opentelekomcloud_elb_listener" "listener_1" {
+  name = "listener_1"
+  protocol = "TCP"
+  protocol_port = 8080
+  backend_protocol = "TCP"
+  backend_port = 8080
+  lb_algorithm = "roundrobin"
+  loadbalancer_id = "${opentelekomcloud_elb_loadbalancer.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_elb_backends" "backends_1" {
+  listener_id = "${opentelekomcloud_elb_listener.listener_1.id}"
+
+  backend {
+    server_id = "${opentelekomcloud_compute_instance_v2.vm_1.id}"
+    address = "${opentelekomcloud_compute_instance_v2.vm_1.network.0.fixed_ip_v4}"
+  }
+
+  backend {
+    server_id = "${opentelekomcloud_compute_instance_v2.vm_2.id}"
+    address = "${opentelekomcloud_compute_instance_v2.vm_2.network.0.fixed_ip_v4}"
+  }
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID)
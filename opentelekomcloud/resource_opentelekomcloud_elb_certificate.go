@@ -0,0 +1,97 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceECertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceECertificateCreate,
+		Read:   resourceECertificateRead,
+		Update: resourceECertificateUpdate,
+		Delete: resourceECertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: certificateSchema(),
+	}
+}
+
+func resourceECertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ELB client: %s", err)
+	}
+
+	createOpts := certificateCreateOpts(d)
+
+	log.Printf("[DEBUG] Creating ELB certificate %s", createOpts.Name)
+	cert, err := elbCreateCertificate(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ELB certificate: %s", err)
+	}
+
+	d.SetId(cert.ID)
+	log.Printf("[DEBUG] Successfully created ELB certificate %s", cert.ID)
+
+	return resourceECertificateRead(d, meta)
+}
+
+func resourceECertificateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ELB client: %s", err)
+	}
+
+	cert, err := elbGetCertificate(client, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "ELB certificate")
+	}
+
+	log.Printf("[DEBUG] Retrieved ELB certificate %s: %s", d.Id(), cert.Name)
+
+	setCertificateAttributes(d, cert)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceECertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ELB client: %s", err)
+	}
+
+	updateOpts := certificateUpdateOpts(d)
+
+	log.Printf("[DEBUG] Updating ELB certificate %s", d.Id())
+	if err := elbUpdateCertificate(client, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud ELB certificate (%s): %s", d.Id(), err)
+	}
+
+	return resourceECertificateRead(d, meta)
+}
+
+func resourceECertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ELB client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting ELB certificate %s", d.Id())
+	if err := elbDeleteCertificate(client, d.Id()); err != nil {
+		return CheckDeleted(d, err, "ELB certificate")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,164 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesImageAccessAcceptV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesImageAccessAcceptV2Create,
+		Read:   resourceImagesImageAccessAcceptV2Read,
+		Update: resourceImagesImageAccessAcceptV2Update,
+		Delete: resourceImagesImageAccessAcceptV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "accepted",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"accepted", "rejected", "pending"})
+				},
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesImageAccessAcceptV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := d.Get("member_id").(string)
+	if memberID == "" {
+		// The image is accepted on behalf of the project of the provider
+		memberID = config.HwClient.ProjectID
+	}
+
+	// The owner has to share the image first
+	if _, err := members.Get(imageClient, imageID, memberID).Extract(); err != nil {
+		return fmt.Errorf("Error retrieving membership of project %s for image %s: %s", memberID, imageID, err)
+	}
+
+	status := d.Get("status").(string)
+	log.Printf("[DEBUG] Setting status of image %s for project %s to %s", imageID, memberID, status)
+	_, err = members.Update(imageClient, imageID, memberID, members.UpdateOpts{Status: status}).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating membership of project %s for image %s: %s", memberID, imageID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", imageID, memberID))
+
+	return resourceImagesImageAccessAcceptV2Read(d, meta)
+}
+
+func resourceImagesImageAccessAcceptV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := members.Get(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "image member")
+	}
+
+	log.Printf("[DEBUG] Retrieved image member %s: %#v", d.Id(), member)
+
+	d.Set("image_id", member.ImageID)
+	d.Set("member_id", member.MemberID)
+	d.Set("status", member.Status)
+	d.Set("created_at", member.CreatedAt.String())
+	d.Set("updated_at", member.UpdatedAt.String())
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesImageAccessAcceptV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("status") {
+		status := d.Get("status").(string)
+		log.Printf("[DEBUG] Setting status of image %s for project %s to %s", imageID, memberID, status)
+		_, err = members.Update(imageClient, imageID, memberID, members.UpdateOpts{Status: status}).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating membership of project %s for image %s: %s", memberID, imageID, err)
+		}
+	}
+
+	return resourceImagesImageAccessAcceptV2Read(d, meta)
+}
+
+func resourceImagesImageAccessAcceptV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// A member can not remove itself, so the image is rejected instead
+	log.Printf("[DEBUG] Rejecting image %s for project %s", imageID, memberID)
+	_, err = members.Update(imageClient, imageID, memberID, members.UpdateOpts{Status: "rejected"}).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "image member")
+	}
+
+	d.SetId("")
+	return nil
+}
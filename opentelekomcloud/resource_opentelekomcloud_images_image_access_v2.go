@@ -0,0 +1,132 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesImageAccessV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesImageAccessV2Create,
+		Read:   resourceImagesImageAccessV2Read,
+		Delete: resourceImagesImageAccessV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesImageAccessV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := d.Get("member_id").(string)
+
+	log.Printf("[DEBUG] Sharing image %s with project %s", imageID, memberID)
+	member, err := members.Create(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error sharing image %s with project %s: %s", imageID, memberID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", member.ImageID, member.MemberID))
+
+	return resourceImagesImageAccessV2Read(d, meta)
+}
+
+func resourceImagesImageAccessV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := members.Get(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "image member")
+	}
+
+	log.Printf("[DEBUG] Retrieved image member %s: %#v", d.Id(), member)
+
+	d.Set("image_id", member.ImageID)
+	d.Set("member_id", member.MemberID)
+	d.Set("status", member.Status)
+	d.Set("created_at", member.CreatedAt.String())
+	d.Set("updated_at", member.UpdatedAt.String())
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesImageAccessV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Removing project %s from image %s", memberID, imageID)
+	if err := members.Delete(imageClient, imageID, memberID).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "image member")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseImagesImageAccessID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("Unable to determine image member ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
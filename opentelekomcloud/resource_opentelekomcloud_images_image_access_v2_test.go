@@ -0,0 +1,181 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesImageAccessV2_basic(t *testing.T) {
+	var member members.Member
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckImageShare(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageAccessV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageAccessV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageAccessV2Exists("opentelekomcloud_images_image_access_v2.access_1", &member),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_images_image_access_v2.access_1", "member_id", OS_IMAGE_SHARE_PROJECT),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_images_image_access_v2.access_1", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageAccessV2_accept(t *testing.T) {
+	var member members.Member
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckImageShare(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageAccessV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageAccessV2_accept_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageAccessV2Exists("opentelekomcloud_images_image_access_v2.access_1", &member),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_images_image_access_accept_v2.accept_1", "member_id", OS_IMAGE_SHARE_PROJECT),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_images_image_access_accept_v2.accept_1", "status", "accepted"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_images_image_v2.image_1", "id",
+						"opentelekomcloud_images_image_v2.image_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesImageAccessV2_accept_2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_images_image_access_accept_v2.accept_1", "status", "rejected"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesImageAccessV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud Image: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_images_image_access_v2" {
+			continue
+		}
+
+		imageID, memberID, err := parseImagesImageAccessID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = members.Get(imageClient, imageID, memberID).Extract()
+		if err == nil {
+			return fmt.Errorf("Image member still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesImageAccessV2Exists(n string, member *members.Member) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud Image: %s", err)
+		}
+
+		imageID, memberID, err := parseImagesImageAccessID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := members.Get(imageClient, imageID, memberID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ImageID != imageID || found.MemberID != memberID {
+			return fmt.Errorf("Image member not found")
+		}
+
+		*member = *found
+
+		return nil
+	}
+}
+
+var testAccImagesImageAccessV2_image = `
+resource "opentelekomcloud_images_image_v2" "image_1" {
+  name   = "Rancher TerraformAccTest"
+  image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+  container_format = "bare"
+  disk_format = "qcow2"
+}`
+
+var testAccImagesImageAccessV2_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_images_image_access_v2" "access_1" {
+  image_id  = "${opentelekomcloud_images_image_v2.image_1.id}"
+  member_id = "%s"
+}
+`, testAccImagesImageAccessV2_image, OS_IMAGE_SHARE_PROJECT)
+
+var testAccImagesImageAccessV2_accept_1 = fmt.Sprintf(`
+%s
+
+provider "opentelekomcloud" {
+  alias     = "member"
+  tenant_id = "%s"
+}
+
+resource "opentelekomcloud_images_image_access_accept_v2" "accept_1" {
+  provider = "opentelekomcloud.member"
+  image_id = "${opentelekomcloud_images_image_access_v2.access_1.image_id}"
+}
+
+data "opentelekomcloud_images_image_v2" "image_1" {
+  provider      = "opentelekomcloud.member"
+  name          = "${opentelekomcloud_images_image_v2.image_1.name}"
+  member_status = "accepted"
+  depends_on    = ["opentelekomcloud_images_image_access_accept_v2.accept_1"]
+}
+`, testAccImagesImageAccessV2_basic, OS_IMAGE_SHARE_PROJECT)
+
+var testAccImagesImageAccessV2_accept_2 = fmt.Sprintf(`
+%s
+
+provider "opentelekomcloud" {
+  alias     = "member"
+  tenant_id = "%s"
+}
+
+resource "opentelekomcloud_images_image_access_accept_v2" "accept_1" {
+  provider = "opentelekomcloud.member"
+  image_id = "${opentelekomcloud_images_image_access_v2.access_1.image_id}"
+  status   = "rejected"
+}
+`, testAccImagesImageAccessV2_basic, OS_IMAGE_SHARE_PROJECT)
@@ -11,6 +11,8 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imagedata"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
@@ -72,9 +74,9 @@ func resourceImagesImageV2() *schema.Resource {
 			},
 
 			"image_cache_path": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  fmt.Sprintf("%s/.terraform/image_cache", os.Getenv("HOME")),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressImageCachePathDiff,
 			},
 
 			"image_source_url": &schema.Schema{
@@ -91,6 +93,25 @@ func resourceImagesImageV2() *schema.Resource {
 				ConflictsWith: []string{"image_source_url"},
 			},
 
+			"verify_checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"obs_bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"obs_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"metadata": &schema.Schema{
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -193,6 +214,10 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 		createOpts.Tags = resourceImagesImageV2BuildTags(tags)
 	}
 
+	if _, ok := d.GetOk("obs_bucket"); ok {
+		return resourceImagesImageV2CreateFromObs(d, meta, createOpts)
+	}
+
 	d.Partial(true)
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -203,28 +228,28 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(newImg.ID)
 
-	// downloading/getting image file props
-	imgFilePath, err := resourceImagesImageV2File(d)
+	imgData, fileSize, err := resourceImagesImageV2Source(d)
 	if err != nil {
-		return fmt.Errorf("Error opening file for Image: %s", err)
-
-	}
-	fileSize, fileChecksum, err := resourceImagesImageV2FileProps(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error getting file props: %s", err)
-	}
-
-	// upload
-	imgFile, err := os.Open(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error opening file %q: %s", imgFilePath, err)
+		return err
 	}
-	defer imgFile.Close()
+	defer imgData.Close()
 	log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
 
-	res := imagedata.Upload(imageClient, d.Id(), imgFile)
+	// the checksum is computed while uploading, so the data is read only once
+	hash := md5.New()
+	res := imagedata.Upload(imageClient, d.Id(), io.TeeReader(imgData, hash))
 	if res.Err != nil {
-		return fmt.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+		return fmt.Errorf("Error while uploading image %s: %s", d.Id(), res.Err)
+	}
+
+	fileChecksum := hex.EncodeToString(hash.Sum(nil))
+	if err := resourceImagesImageV2VerifyChecksum(d, fileChecksum); err != nil {
+		log.Printf("[DEBUG] Deleting Image %s", d.Id())
+		if err := images.Delete(imageClient, d.Id()).Err; err != nil {
+			log.Printf("[WARN] Error deleting Image %s: %s", d.Id(), err)
+		}
+		d.SetId("")
+		return err
 	}
 
 	//wait for active
@@ -246,6 +271,91 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 	return resourceImagesImageV2Read(d, meta)
 }
 
+// resourceImagesImageV2CreateFromObs stages the image data in OBS and
+// registers it through IMS, which supports larger images than an upload to
+// Glance and allows resuming the upload of local files.
+func resourceImagesImageV2CreateFromObs(d *schema.ResourceData, meta interface{}, createOpts *images.CreateOpts) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud image client: %s", err)
+	}
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return err
+	}
+
+	if createOpts.MinDisk == 0 {
+		return fmt.Errorf("min_disk_gb must be set when the image is uploaded through OBS")
+	}
+	if createOpts.ContainerFormat != "bare" || *createOpts.Protected {
+		return fmt.Errorf("Images uploaded through OBS must use the \"bare\" container_format and can not be protected")
+	}
+
+	bucket := d.Get("obs_bucket").(string)
+	key, err := resourceImagesImageV2ObsKey(d)
+	if err != nil {
+		return err
+	}
+	d.Set("obs_key", key)
+
+	imgData, fileSize, err := resourceImagesImageV2Source(d)
+	if err != nil {
+		return err
+	}
+	defer imgData.Close()
+	log.Printf("[WARN] Uploading image to %s/%s (%d bytes). This can be pretty long.", bucket, key, fileSize)
+
+	// only files can be read again to resume an upload
+	_, resume := imgData.(*os.File)
+	hash := md5.New()
+	if err := imagesImageV2ObsUpload(s3conn, bucket, key, io.TeeReader(imgData, hash), resume); err != nil {
+		return err
+	}
+
+	defer func() {
+		log.Printf("[DEBUG] Deleting staged image %s/%s", bucket, key)
+		_, err := s3conn.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			log.Printf("[WARN] Error deleting staged image %s/%s: %s", bucket, key, err)
+		}
+	}()
+
+	if err := resourceImagesImageV2VerifyChecksum(d, hex.EncodeToString(hash.Sum(nil))); err != nil {
+		return err
+	}
+
+	opts := ImsImageCreateOpts{
+		Name:     createOpts.Name,
+		ImageURL: fmt.Sprintf("%s:%s", bucket, key),
+		MinDisk:  createOpts.MinDisk,
+		MinRAM:   createOpts.MinRAM,
+	}
+	if err := resourceImsImageV2Create(d, config, opts); err != nil {
+		return err
+	}
+
+	// IMS creates private images without tags
+	updateOpts := make(images.UpdateOpts, 0)
+	if *createOpts.Visibility != images.ImageVisibilityPrivate {
+		updateOpts = append(updateOpts, images.UpdateVisibility{Visibility: *createOpts.Visibility})
+	}
+	if len(createOpts.Tags) > 0 {
+		updateOpts = append(updateOpts, images.ReplaceImageTags{NewTags: createOpts.Tags})
+	}
+	if len(updateOpts) > 0 {
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		if _, err := images.Update(imageClient, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating image: %s", err)
+		}
+	}
+
+	return resourceImagesImageV2Read(d, meta)
+}
+
 func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	imageClient, err := config.imageV2Client(GetRegion(d, config))
@@ -412,40 +522,6 @@ func resourceImagesImageV2VisibilityFromString(v string) images.ImageVisibility
 	return ""
 }
 
-func fileMD5Checksum(f *os.File) (string, error) {
-	hash := md5.New()
-	if _, err := io.Copy(hash, f); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(hash.Sum(nil)), nil
-}
-
-func resourceImagesImageV2FileProps(filename string) (int64, string, error) {
-	var filesize int64
-	var filechecksum string
-
-	file, err := os.Open(filename)
-	if err != nil {
-		return -1, "", fmt.Errorf("Error opening file for Image: %s", err)
-
-	}
-	defer file.Close()
-
-	fstat, err := file.Stat()
-	if err != nil {
-		return -1, "", fmt.Errorf("Error reading image file %q: %s", file.Name(), err)
-	}
-
-	filesize = fstat.Size()
-	filechecksum, err = fileMD5Checksum(file)
-
-	if err != nil {
-		return -1, "", fmt.Errorf("Error computing image file %q checksum: %s", file.Name(), err)
-	}
-
-	return filesize, filechecksum, nil
-}
-
 func resourceImagesImageV2File(d *schema.ResourceData) (string, error) {
 	if filename := d.Get("local_file_path").(string); filename != "" {
 		return filename, nil
@@ -2,6 +2,7 @@ package opentelekomcloud
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
@@ -156,6 +157,42 @@ func TestAccImagesImageV2_timeout(t *testing.T) {
 	})
 }
 
+func TestAccImagesImageV2_verifyChecksum(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccImagesImageV2_verifyChecksum,
+				ExpectError: regexp.MustCompile(`Image checksum mismatch`),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_obs(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageV2_obs,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("opentelekomcloud_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_images_image_v2.image_1", "obs_key", "rancheros-openstack.img"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_images_image_v2.image_1", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImagesImageV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	imageClient, err := config.imageV2Client(OS_REGION_NAME)
@@ -359,3 +396,28 @@ var testAccImagesImageV2_timeout = `
         create = "10m"
       }
   }`
+
+var testAccImagesImageV2_verifyChecksum = `
+  resource "opentelekomcloud_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      verify_checksum = "00000000000000000000000000000000"
+  }`
+
+var testAccImagesImageV2_obs = `
+  resource "opentelekomcloud_s3_bucket" "bucket_1" {
+      bucket = "tf-acc-test-image-staging"
+      force_destroy = true
+  }
+
+  resource "opentelekomcloud_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      min_disk_gb = 10
+      obs_bucket = "${opentelekomcloud_s3_bucket.bucket_1.bucket}"
+      tags = ["foo"]
+  }`
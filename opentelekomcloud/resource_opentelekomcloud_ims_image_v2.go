@@ -0,0 +1,99 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImsImageV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImsImageV2FromServerCreate,
+		Read:   resourceImsImageV2Read,
+		Update: resourceImsImageV2Update,
+		Delete: resourceImsImageV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: resourceImsImageV2ComputedSchema(map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"volume_id"},
+			},
+			"volume_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_id"},
+			},
+			"min_ram": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"volume_id"},
+			},
+			"max_ram": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"volume_id"},
+			},
+		}),
+	}
+}
+
+func resourceImsImageV2FromServerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+
+	if v, ok := d.GetOk("instance_id"); ok {
+		createOpts := ImsImageCreateOpts{
+			Name:        name,
+			Description: description,
+			InstanceID:  v.(string),
+			MinRAM:      d.Get("min_ram").(int),
+			MaxRAM:      d.Get("max_ram").(int),
+		}
+		if err := resourceImsImageV2Create(d, config, createOpts); err != nil {
+			return err
+		}
+	} else if v, ok := d.GetOk("volume_id"); ok {
+		createOpts := ImsDataImageCreateOpts{
+			Name:        name,
+			Description: description,
+			VolumeID:    v.(string),
+		}
+		if err := resourceImsDataImageV2Create(d, config, createOpts); err != nil {
+			return err
+		}
+	} else {
+		return fmt.Errorf("One of instance_id or volume_id must be set")
+	}
+
+	return resourceImsImageV2Read(d, meta)
+}
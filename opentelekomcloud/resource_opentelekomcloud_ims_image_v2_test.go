@@ -0,0 +1,193 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImsImageV2_instance(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImsImageV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImsImageV2_instance,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("opentelekomcloud_ims_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ims_image_v2.image_1", "name", "ims_image_1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ims_image_v2.image_1", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ims_image_v2.image_1", "visibility", "private"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImsImageV2_instanceUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("opentelekomcloud_ims_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ims_image_v2.image_1", "name", "ims_image_1_updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImsImageV2_volume(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImsImageV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImsImageV2_volume,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("opentelekomcloud_ims_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ims_image_v2.image_1", "name", "ims_data_image_1"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_ims_image_v2.image_1", "volume_id",
+						"opentelekomcloud_blockstorage_volume_v2.volume_1", "id"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ims_image_v2.image_1", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImsObsImageV2_basic(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckImageLocalPath(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImsImageV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImsObsImageV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("opentelekomcloud_ims_obs_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ims_obs_image_v2.image_1", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ims_obs_image_v2.image_1", "min_disk_gb", "40"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImsImageV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud Image: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ims_image_v2" && rs.Type != "opentelekomcloud_ims_obs_image_v2" {
+			continue
+		}
+
+		_, err := images.Get(imageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Image still exists")
+		}
+	}
+
+	return nil
+}
+
+var testAccImsImageV2_instance = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_ims_image_v2" "image_1" {
+  name = "ims_image_1"
+  instance_id = "${opentelekomcloud_compute_instance_v2.instance_1.id}"
+  description = "created by terraform"
+}
+`, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
+
+var testAccImsImageV2_instanceUpdate = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_ims_image_v2" "image_1" {
+  name = "ims_image_1_updated"
+  instance_id = "${opentelekomcloud_compute_instance_v2.instance_1.id}"
+  description = "created by terraform"
+}
+`, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
+
+var testAccImsImageV2_volume = fmt.Sprintf(`
+resource "opentelekomcloud_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 40
+  availability_zone = "%s"
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_compute_volume_attach_v2" "va_1" {
+  instance_id = "${opentelekomcloud_compute_instance_v2.instance_1.id}"
+  volume_id = "${opentelekomcloud_blockstorage_volume_v2.volume_1.id}"
+}
+
+resource "opentelekomcloud_ims_image_v2" "image_1" {
+  name = "ims_data_image_1"
+  description = "created by terraform"
+  volume_id = "${opentelekomcloud_compute_volume_attach_v2.va_1.volume_id}"
+}
+`, OS_AVAILABILITY_ZONE, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
+
+var testAccImsObsImageV2_basic = fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "image_bucket" {
+  bucket = "tf-ims-image-test-bucket"
+}
+
+resource "opentelekomcloud_s3_bucket_object" "image" {
+  bucket = "${opentelekomcloud_s3_bucket.image_bucket.bucket}"
+  key = "image.qcow2"
+  source = "%s"
+  content_type = "binary/octet-stream"
+}
+
+resource "opentelekomcloud_ims_obs_image_v2" "image_1" {
+  name = "ims_obs_image_1"
+  bucket = "${opentelekomcloud_s3_bucket_object.image.bucket}"
+  key = "${opentelekomcloud_s3_bucket_object.image.key}"
+  min_disk = 40
+  os_version = "Ubuntu 16.04 server 64bit"
+}
+`, OS_IMAGE_LOCAL_PATH)
@@ -0,0 +1,105 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImsObsImageV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImsObsImageV2Create,
+		Read:   resourceImsImageV2Read,
+		Update: resourceImsImageV2Update,
+		Delete: resourceImsImageV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: resourceImsImageV2ComputedSchema(map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"min_disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"os_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"is_config": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"kms_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"min_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"max_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+		}),
+	}
+}
+
+func resourceImsObsImageV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	createOpts := ImsImageCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ImageURL:    fmt.Sprintf("%s:%s", d.Get("bucket").(string), d.Get("key").(string)),
+		MinDisk:     d.Get("min_disk").(int),
+		OsVersion:   d.Get("os_version").(string),
+		IsConfig:    d.Get("is_config").(bool),
+		CmkID:       d.Get("kms_key_id").(string),
+		MinRAM:      d.Get("min_ram").(int),
+		MaxRAM:      d.Get("max_ram").(int),
+	}
+
+	if err := resourceImsImageV2Create(d, config, createOpts); err != nil {
+		return err
+	}
+
+	return resourceImsImageV2Read(d, meta)
+}
@@ -0,0 +1,128 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCertificateV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCertificateV2Create,
+		Read:   resourceCertificateV2Read,
+		Update: resourceCertificateV2Update,
+		Delete: resourceCertificateV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceCertificateV2Schema(),
+	}
+}
+
+func resourceCertificateV2Schema() map[string]*schema.Schema {
+	s := certificateSchema()
+
+	s["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "server",
+		ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+			return ValidateStringList(v, k, []string{"server", "client"})
+		},
+	}
+
+	// Client certificates have no private key.
+	s["private_key"].Required = false
+	s["private_key"].Optional = true
+
+	return s
+}
+
+func resourceCertificateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	createOpts := certificateCreateOpts(d)
+	createOpts.Type = d.Get("type").(string)
+
+	if createOpts.Type == "server" && createOpts.PrivateKey == "" {
+		return fmt.Errorf("private_key is required for server certificates")
+	}
+
+	log.Printf("[DEBUG] Creating LB certificate %s", createOpts.Name)
+	cert, err := lbV2CreateCertificate(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LB certificate: %s", err)
+	}
+
+	d.SetId(cert.ID)
+	log.Printf("[DEBUG] Successfully created LB certificate %s", cert.ID)
+
+	return resourceCertificateV2Read(d, meta)
+}
+
+func resourceCertificateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	cert, err := lbV2GetCertificate(client, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "LB certificate")
+	}
+
+	log.Printf("[DEBUG] Retrieved LB certificate %s: %s", d.Id(), cert.Name)
+
+	setCertificateAttributes(d, cert)
+	d.Set("type", cert.Type)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceCertificateV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if d.HasChange("certificate") || d.HasChange("private_key") {
+		if d.Get("type").(string) == "server" && d.Get("private_key").(string) == "" {
+			return fmt.Errorf("private_key is required for server certificates")
+		}
+	}
+	updateOpts := certificateUpdateOpts(d)
+
+	log.Printf("[DEBUG] Updating LB certificate %s", d.Id())
+	if err := lbV2UpdateCertificate(client, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud LB certificate (%s): %s", d.Id(), err)
+	}
+
+	return resourceCertificateV2Read(d, meta)
+}
+
+func resourceCertificateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting LB certificate %s", d.Id())
+	if err := lbV2DeleteCertificate(client, d.Id()); err != nil {
+		return CheckDeleted(d, err, "LB certificate")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,150 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2Certificate_basic(t *testing.T) {
+	var cert Certificate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2CertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2Certificate_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2CertificateExists("opentelekomcloud_lb_certificate_v2.certificate_1", &cert),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "name", "certificate_1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "domain", "www.example.com"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "type", "server"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "expire_time"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2Certificate_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2CertificateRotated("opentelekomcloud_lb_certificate_v2.certificate_1", &cert),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "name", "certificate_1_updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2CertificateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_certificate_v2" {
+			continue
+		}
+
+		_, err := lbV2GetCertificate(client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("LB certificate still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2CertificateExists(n string, cert *Certificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := lbV2GetCertificate(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("LB certificate not found")
+		}
+
+		*cert = *found
+
+		return nil
+	}
+}
+
+// testAccCheckLBV2CertificateRotated checks that the rotated certificate was
+// uploaded to the existing certificate instead of a new one.
+func testAccCheckLBV2CertificateRotated(n string, cert *Certificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID != cert.ID {
+			return fmt.Errorf("LB certificate was recreated: %s != %s", rs.Primary.ID, cert.ID)
+		}
+
+		expireTime, err := certificateExpireTime(testAccCertificateRotated)
+		if err != nil {
+			return err
+		}
+		if rs.Primary.Attributes["expire_time"] != expireTime {
+			return fmt.Errorf("Bad expire_time: expected %s, got %s",
+				expireTime, rs.Primary.Attributes["expire_time"])
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV2Certificate_basic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_certificate_v2" "certificate_1" {
+  name        = "certificate_1"
+  description = "terraform test certificate"
+  domain      = "www.example.com"
+  certificate = <<EOT
+%s
+EOT
+  private_key = <<EOT
+%s
+EOT
+}
+`, testAccCertificate, testAccPrivateKey)
+
+var testAccLBV2Certificate_update = fmt.Sprintf(`
+resource "opentelekomcloud_lb_certificate_v2" "certificate_1" {
+  name        = "certificate_1_updated"
+  description = "terraform test certificate"
+  domain      = "www.example.com"
+  certificate = <<EOT
+%s
+EOT
+  private_key = <<EOT
+%s
+EOT
+}
+`, testAccCertificateRotated, testAccPrivateKeyRotated)
@@ -0,0 +1,311 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceL7PolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7PolicyV2Create,
+		Read:   resourceL7PolicyV2Read,
+		Update: resourceL7PolicyV2Update,
+		Delete: resourceL7PolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"REDIRECT_TO_POOL", "REDIRECT_TO_LISTENER"})
+				},
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"position": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"redirect_pool_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_listener_id"},
+			},
+
+			"redirect_listener_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_pool_id"},
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// checkL7PolicyV2Redirect checks that the redirect target matching the action
+// of the policy is set.
+func checkL7PolicyV2Redirect(d *schema.ResourceData) error {
+	switch d.Get("action").(string) {
+	case "REDIRECT_TO_POOL":
+		if d.Get("redirect_pool_id").(string) == "" {
+			return fmt.Errorf("redirect_pool_id must be set if using 'REDIRECT_TO_POOL' action.")
+		}
+	case "REDIRECT_TO_LISTENER":
+		if d.Get("redirect_listener_id").(string) == "" {
+			return fmt.Errorf("redirect_listener_id must be set if using 'REDIRECT_TO_LISTENER' action.")
+		}
+	}
+	return nil
+}
+
+func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if err := checkL7PolicyV2Redirect(d); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := LBL7PolicyV2CreateOpts{
+		TenantID:           d.Get("tenant_id").(string),
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		ListenerID:         d.Get("listener_id").(string),
+		Action:             d.Get("action").(string),
+		Position:           int32(d.Get("position").(int)),
+		RedirectPoolID:     d.Get("redirect_pool_id").(string),
+		RedirectListenerID: d.Get("redirect_listener_id").(string),
+		AdminStateUp:       &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutCreate)
+	listenerID := createOpts.ListenerID
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to create l7policy")
+	var l7policy *LBL7PolicyV2
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7policy, err = lbV2CreateL7Policy(networkingClient, createOpts)
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error creating l7policy: %s", err)
+	}
+
+	// Wait for L7 Policy to become active before continuing
+	err = waitForLBV2L7Policy(networkingClient, l7policy.ID, "ACTIVE", []string{"PENDING_CREATE"}, timeout)
+	if err != nil {
+		return err
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7policy.ID)
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	l7policy, err := lbV2GetL7Policy(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "l7policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved l7policy %s: %#v", d.Id(), l7policy)
+
+	d.Set("action", l7policy.Action)
+	d.Set("description", l7policy.Description)
+	d.Set("tenant_id", l7policy.TenantID)
+	d.Set("name", l7policy.Name)
+	d.Set("position", int(l7policy.Position))
+	d.Set("redirect_pool_id", l7policy.RedirectPoolID)
+	d.Set("redirect_listener_id", l7policy.RedirectListenerID)
+	d.Set("admin_state_up", l7policy.AdminStateUp)
+	d.Set("listener_id", l7policy.ListenerID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if err := checkL7PolicyV2Redirect(d); err != nil {
+		return err
+	}
+
+	var updateOpts LBL7PolicyV2UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("position") {
+		updateOpts.Position = int32(d.Get("position").(int))
+	}
+	if d.HasChange("redirect_pool_id") {
+		redirectPoolID := d.Get("redirect_pool_id").(string)
+		updateOpts.RedirectPoolID = &redirectPoolID
+	}
+	if d.HasChange("redirect_listener_id") {
+		redirectListenerID := d.Get("redirect_listener_id").(string)
+		updateOpts.RedirectListenerID = &redirectListenerID
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating l7policy %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2UpdateL7Policy(networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update l7policy %s: %s", d.Id(), err)
+	}
+
+	// Wait for L7 Policy to become active before continuing
+	err = waitForLBV2L7Policy(networkingClient, d.Id(), "ACTIVE", []string{"PENDING_UPDATE"}, timeout)
+	if err != nil {
+		return err
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutDelete)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to delete l7policy %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2DeleteL7Policy(networkingClient, d.Id())
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return CheckDeleted(d, err, "l7policy")
+	}
+
+	// Wait for L7 Policy to delete
+	err = waitForLBV2L7Policy(networkingClient, d.Id(), "DELETED", []string{"ACTIVE", "PENDING_DELETE"}, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
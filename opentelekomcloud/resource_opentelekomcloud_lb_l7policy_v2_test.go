@@ -0,0 +1,216 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2L7Policy_basic(t *testing.T) {
+	var l7policy LBL7PolicyV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2L7PolicyConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("opentelekomcloud_lb_l7policy_v2.l7policy_1", &l7policy),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "name", "l7policy_1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "redirect_pool_id",
+						"opentelekomcloud_lb_pool_v2.pool_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2L7PolicyConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "name", "l7policy_1_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "description", "test l7policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2L7Policy_redirectToListener(t *testing.T) {
+	var l7policy LBL7PolicyV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2L7PolicyConfig_redirectToListener,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("opentelekomcloud_lb_l7policy_v2.l7policy_1", &l7policy),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_LISTENER"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "redirect_listener_id",
+						"opentelekomcloud_lb_listener_v2.listener_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_l7policy_v2" {
+			continue
+		}
+
+		_, err := lbV2GetL7Policy(networkingClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("L7 Policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7PolicyExists(n string, l7policy *LBL7PolicyV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := lbV2GetL7Policy(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L7 Policy not found")
+		}
+
+		*l7policy = *found
+
+		return nil
+	}
+}
+
+var TestAccLBV2L7PolicyConfig_basic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REDIRECT_TO_POOL"
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+}
+`, OS_SUBNET_ID)
+
+var TestAccLBV2L7PolicyConfig_update = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1_updated"
+  description = "test l7policy"
+  action = "REDIRECT_TO_POOL"
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+}
+`, OS_SUBNET_ID)
+
+var TestAccLBV2L7PolicyConfig_redirectToListener = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_certificate_v2" "certificate_1" {
+  name = "certificate_1"
+  domain = "www.example.com"
+  certificate = <<EOT
+%s
+EOT
+  private_key = <<EOT
+%s
+EOT
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_2" {
+  name = "listener_2"
+  protocol = "TERMINATED_HTTPS"
+  protocol_port = 8443
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+  default_tls_container_ref = "${opentelekomcloud_lb_certificate_v2.certificate_1.id}"
+}
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REDIRECT_TO_LISTENER"
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+  redirect_listener_id = "${opentelekomcloud_lb_listener_v2.listener_2.id}"
+}
+`, OS_SUBNET_ID, testAccCertificate, testAccPrivateKey)
@@ -0,0 +1,318 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceL7RuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7RuleV2Create,
+		Read:   resourceL7RuleV2Read,
+		Update: resourceL7RuleV2Update,
+		Delete: resourceL7RuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"l7policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"HOST_NAME", "PATH"})
+				},
+			},
+
+			"compare_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"EQUAL_TO", "STARTS_WITH", "REGEX"})
+				},
+			},
+
+			"value": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// checkL7RuleV2CompareType checks that host name rules only use exact
+// matching, which is the only comparison the load balancer supports for them.
+func checkL7RuleV2CompareType(d *schema.ResourceData) error {
+	if d.Get("type").(string) == "HOST_NAME" && d.Get("compare_type").(string) != "EQUAL_TO" {
+		return fmt.Errorf("Only 'EQUAL_TO' is supported for 'compare_type' if using 'HOST_NAME' type.")
+	}
+	return nil
+}
+
+func resourceL7RuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if err := checkL7RuleV2CompareType(d); err != nil {
+		return err
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	l7policy, err := lbV2GetL7Policy(networkingClient, l7policyID)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve l7policy %s: %s", l7policyID, err)
+	}
+	listenerID := l7policy.ListenerID
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := LBL7RuleV2Opts{
+		TenantID:     d.Get("tenant_id").(string),
+		RuleType:     d.Get("type").(string),
+		CompareType:  d.Get("compare_type").(string),
+		Value:        d.Get("value").(string),
+		AdminStateUp: &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to create l7rule")
+	var l7rule *LBL7RuleV2
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7rule, err = lbV2CreateL7Rule(networkingClient, l7policyID, createOpts)
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error creating l7rule: %s", err)
+	}
+
+	// Wait for L7 Policy to become active again before continuing
+	err = waitForLBV2L7Policy(networkingClient, l7policyID, "ACTIVE", []string{"PENDING_UPDATE"}, timeout)
+	if err != nil {
+		return err
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", l7policyID, l7rule.ID))
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	l7policyID, l7ruleID, err := parseL7RuleV2Id(d.Id())
+	if err != nil {
+		return err
+	}
+
+	l7policy, err := lbV2GetL7Policy(networkingClient, l7policyID)
+	if err != nil {
+		return CheckDeleted(d, err, "l7policy")
+	}
+
+	l7rule, err := lbV2GetL7Rule(networkingClient, l7policyID, l7ruleID)
+	if err != nil {
+		return CheckDeleted(d, err, "l7rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved l7rule %s: %#v", d.Id(), l7rule)
+
+	d.Set("l7policy_id", l7policyID)
+	d.Set("listener_id", l7policy.ListenerID)
+	d.Set("type", l7rule.RuleType)
+	d.Set("compare_type", l7rule.CompareType)
+	d.Set("value", l7rule.Value)
+	d.Set("tenant_id", l7rule.TenantID)
+	d.Set("admin_state_up", l7rule.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7RuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if err := checkL7RuleV2CompareType(d); err != nil {
+		return err
+	}
+
+	l7policyID, l7ruleID, err := parseL7RuleV2Id(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The type is always sent along, because the value and the comparison
+	// are validated against it.
+	updateOpts := LBL7RuleV2Opts{
+		RuleType: d.Get("type").(string),
+	}
+	if d.HasChange("compare_type") {
+		updateOpts.CompareType = d.Get("compare_type").(string)
+	}
+	if d.HasChange("value") {
+		updateOpts.Value = d.Get("value").(string)
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating l7rule %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2UpdateL7Rule(networkingClient, l7policyID, l7ruleID, updateOpts)
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update l7rule %s: %s", d.Id(), err)
+	}
+
+	// Wait for L7 Policy to become active again before continuing
+	err = waitForLBV2L7Policy(networkingClient, l7policyID, "ACTIVE", []string{"PENDING_UPDATE"}, timeout)
+	if err != nil {
+		return err
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	l7policyID, l7ruleID, err := parseL7RuleV2Id(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutDelete)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to delete l7rule %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2DeleteL7Rule(networkingClient, l7policyID, l7ruleID)
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return CheckDeleted(d, err, "l7rule")
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func parseL7RuleV2Id(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("Unable to determine l7rule ID")
+	}
+
+	l7policyID := idParts[0]
+	l7ruleID := idParts[1]
+
+	return l7policyID, l7ruleID, nil
+}
@@ -0,0 +1,195 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2L7Rule_basic(t *testing.T) {
+	var l7rule LBL7RuleV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7RuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2L7RuleConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7RuleExists("opentelekomcloud_lb_l7rule_v2.l7rule_1", &l7rule),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7rule_v2.l7rule_1", "type", "PATH"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7rule_v2.l7rule_1", "compare_type", "STARTS_WITH"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7rule_v2.l7rule_1", "value", "/api"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_lb_l7rule_v2.l7rule_1", "listener_id",
+						"opentelekomcloud_lb_listener_v2.listener_1", "id"),
+					testAccCheckLBV2L7RuleExists("opentelekomcloud_lb_l7rule_v2.l7rule_2", &l7rule),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7rule_v2.l7rule_2", "type", "HOST_NAME"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2L7RuleConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7rule_v2.l7rule_1", "compare_type", "REGEX"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7rule_v2.l7rule_1", "value", "^/api/v[12]/.*"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2L7RuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_l7rule_v2" {
+			continue
+		}
+
+		l7policyID, l7ruleID, err := parseL7RuleV2Id(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = lbV2GetL7Rule(networkingClient, l7policyID, l7ruleID)
+		if err == nil {
+			return fmt.Errorf("L7 Rule still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7RuleExists(n string, l7rule *LBL7RuleV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		l7policyID, l7ruleID, err := parseL7RuleV2Id(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := lbV2GetL7Rule(networkingClient, l7policyID, l7ruleID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != l7ruleID {
+			return fmt.Errorf("L7 Rule not found")
+		}
+
+		*l7rule = *found
+
+		return nil
+	}
+}
+
+var TestAccLBV2L7RuleConfig_basic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REDIRECT_TO_POOL"
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+}
+
+resource "opentelekomcloud_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = "${opentelekomcloud_lb_l7policy_v2.l7policy_1.id}"
+  type = "PATH"
+  compare_type = "STARTS_WITH"
+  value = "/api"
+}
+
+resource "opentelekomcloud_lb_l7rule_v2" "l7rule_2" {
+  l7policy_id = "${opentelekomcloud_lb_l7policy_v2.l7policy_1.id}"
+  type = "HOST_NAME"
+  compare_type = "EQUAL_TO"
+  value = "www.example.com"
+}
+`, OS_SUBNET_ID)
+
+var TestAccLBV2L7RuleConfig_update = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REDIRECT_TO_POOL"
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+}
+
+resource "opentelekomcloud_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = "${opentelekomcloud_lb_l7policy_v2.l7policy_1.id}"
+  type = "PATH"
+  compare_type = "REGEX"
+  value = "^/api/v[12]/.*"
+}
+
+resource "opentelekomcloud_lb_l7rule_v2" "l7rule_2" {
+  l7policy_id = "${opentelekomcloud_lb_l7policy_v2.l7policy_1.id}"
+  type = "HOST_NAME"
+  compare_type = "EQUAL_TO"
+  value = "www.example.com"
+}
+`, OS_SUBNET_ID)
@@ -93,6 +93,12 @@ func resourceMemberV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"wait_for_healthy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -155,8 +161,17 @@ func resourceMemberV2Create(d *schema.ResourceData, meta interface{}) error {
 			return err
 		} */
 
+	// The member exists from here on, so it is tracked in the state even if
+	// it does not become healthy.
 	d.SetId(member.ID)
 
+	if d.Get("wait_for_healthy").(bool) && adminStateUp {
+		err = waitForLBV2MembersHealthy(networkingClient, poolID, []string{member.ID}, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceMemberV2Read(d, meta)
 }
 
@@ -232,6 +247,13 @@ func resourceMemberV2Update(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.Get("wait_for_healthy").(bool) && d.Get("admin_state_up").(bool) {
+		err = waitForLBV2MembersHealthy(networkingClient, poolID, []string{d.Id()}, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceMemberV2Read(d, meta)
 }
 
@@ -0,0 +1,381 @@
+package opentelekomcloud
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+// resourceMembersV2 manages all members of a pool. Its ID is the ID of the
+// pool.
+func resourceMembersV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMembersV2Create,
+		Read:   resourceMembersV2Read,
+		Update: resourceMembersV2Update,
+		Delete: resourceMembersV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"pool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceMembersV2MemberHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol_port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"weight": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(int)
+								if value < 1 {
+									errors = append(errors, fmt.Errorf(
+										"Only numbers greater than 0 are supported values for 'weight'"))
+								}
+								return
+							},
+						},
+
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"admin_state_up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Default:  true,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"wait_for_healthy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceMembersV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	members := d.Get("member").(*schema.Set).List()
+
+	// Set the ID first, so members created before a failure are tracked and
+	// reconciled by the next refresh.
+	d.SetId(poolID)
+
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	if err := resourceMembersV2Apply(networkingClient, poolID, nil, members, deadline); err != nil {
+		return err
+	}
+
+	if d.Get("wait_for_healthy").(bool) {
+		if err := resourceMembersV2WaitForHealthy(networkingClient, poolID, deadline); err != nil {
+			return err
+		}
+	}
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	allPages, err := pools.ListMembers(networkingClient, d.Id(), pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return CheckDeleted(d, err, "pool members")
+	}
+
+	allMembers, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve members of pool %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved members of pool %s: %#v", d.Id(), allMembers)
+
+	members := make([]map[string]interface{}, len(allMembers))
+	for i, member := range allMembers {
+		members[i] = map[string]interface{}{
+			"id":             member.ID,
+			"name":           member.Name,
+			"address":        member.Address,
+			"protocol_port":  member.ProtocolPort,
+			"weight":         member.Weight,
+			"subnet_id":      member.SubnetID,
+			"admin_state_up": member.AdminStateUp,
+		}
+	}
+
+	d.Set("pool_id", d.Id())
+	if err := d.Set("member", members); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving member to state for pool (%s): %s", d.Id(), err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceMembersV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+	if d.HasChange("member") {
+		o, n := d.GetChange("member")
+		oldMembers := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		newMembers := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		if err := resourceMembersV2Apply(networkingClient, d.Id(), oldMembers, newMembers, deadline); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("wait_for_healthy").(bool) {
+		if err := resourceMembersV2WaitForHealthy(networkingClient, d.Id(), deadline); err != nil {
+			return err
+		}
+	}
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	members := d.Get("member").(*schema.Set).List()
+
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	err = resourceMembersV2Apply(networkingClient, d.Id(), members, nil, deadline)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			log.Printf("[DEBUG] Pool %s of the members has already been deleted", d.Id())
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// resourceMembersV2Apply removes the members oldMembers from and adds the
+// members newMembers to a pool. Members with the same address and port in
+// both lists are updated in place. The load balancer is only waited for
+// before the first and after the last change, the requests in between are
+// retried while it is busy. The enhanced load balancer has no batch member
+// request, so every member is changed with a request of its own and all of
+// them share the deadline of the operation.
+func resourceMembersV2Apply(networkingClient *gophercloud.ServiceClient, poolID string, oldMembers, newMembers []interface{}, deadline time.Time) error {
+	removed := make(map[string]map[string]interface{})
+	for _, raw := range oldMembers {
+		member := raw.(map[string]interface{})
+		removed[resourceMembersV2MemberKey(member)] = member
+	}
+
+	var creates []pools.CreateMemberOpts
+	updates := make(map[string]pools.UpdateMemberOpts)
+	for _, raw := range newMembers {
+		member := raw.(map[string]interface{})
+		adminStateUp := member["admin_state_up"].(bool)
+
+		key := resourceMembersV2MemberKey(member)
+		if old, ok := removed[key]; ok && old["id"].(string) != "" && old["subnet_id"] == member["subnet_id"] {
+			delete(removed, key)
+			updates[old["id"].(string)] = pools.UpdateMemberOpts{
+				Name:         member["name"].(string),
+				Weight:       member["weight"].(int),
+				AdminStateUp: &adminStateUp,
+			}
+			continue
+		}
+
+		creates = append(creates, pools.CreateMemberOpts{
+			Name:         member["name"].(string),
+			Address:      member["address"].(string),
+			ProtocolPort: member["protocol_port"].(int),
+			Weight:       member["weight"].(int),
+			SubnetID:     member["subnet_id"].(string),
+			AdminStateUp: &adminStateUp,
+		})
+	}
+
+	if len(removed) == 0 && len(updates) == 0 && len(creates) == 0 {
+		return nil
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	err := waitForLBV2viaPool(networkingClient, poolID, "ACTIVE", time.Until(deadline))
+	if err != nil {
+		return err
+	}
+
+	for _, member := range removed {
+		memberID := member["id"].(string)
+		if memberID == "" {
+			continue
+		}
+
+		log.Printf("[DEBUG] Attempting to delete member %s of pool %s", memberID, poolID)
+		err = resource.Retry(time.Until(deadline), func() *resource.RetryError {
+			err = pools.DeleteMember(networkingClient, poolID, memberID).ExtractErr()
+			if err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); ok {
+					return nil
+				}
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("Unable to delete member %s of pool %s: %s", memberID, poolID, err)
+		}
+	}
+
+	for memberID, updateOpts := range updates {
+		log.Printf("[DEBUG] Updating member %s of pool %s with options: %#v", memberID, poolID, updateOpts)
+		err = resource.Retry(time.Until(deadline), func() *resource.RetryError {
+			_, err = pools.UpdateMember(networkingClient, poolID, memberID, updateOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("Unable to update member %s of pool %s: %s", memberID, poolID, err)
+		}
+	}
+
+	for _, createOpts := range creates {
+		log.Printf("[DEBUG] Create Options: %#v", createOpts)
+		err = resource.Retry(time.Until(deadline), func() *resource.RetryError {
+			_, err = pools.CreateMember(networkingClient, poolID, createOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error creating member %s:%d of pool %s: %s",
+				createOpts.Address, createOpts.ProtocolPort, poolID, err)
+		}
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	return waitForLBV2viaPool(networkingClient, poolID, "ACTIVE", time.Until(deadline))
+}
+
+// resourceMembersV2WaitForHealthy waits until all enabled members of a pool
+// are ONLINE or the deadline has passed.
+func resourceMembersV2WaitForHealthy(networkingClient *gophercloud.ServiceClient, poolID string, deadline time.Time) error {
+	allPages, err := pools.ListMembers(networkingClient, poolID, pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve members of pool %s: %s", poolID, err)
+	}
+
+	allMembers, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve members of pool %s: %s", poolID, err)
+	}
+
+	var memberIDs []string
+	for _, member := range allMembers {
+		if member.AdminStateUp {
+			memberIDs = append(memberIDs, member.ID)
+		}
+	}
+
+	if len(memberIDs) == 0 {
+		return nil
+	}
+
+	return waitForLBV2MembersHealthy(networkingClient, poolID, memberIDs, time.Until(deadline))
+}
+
+// resourceMembersV2MemberKey identifies a member within its pool.
+func resourceMembersV2MemberKey(member map[string]interface{}) string {
+	return fmt.Sprintf("%s:%d", member["address"].(string), member["protocol_port"].(int))
+}
+
+func resourceMembersV2MemberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", resourceMembersV2MemberKey(m)))
+	buf.WriteString(fmt.Sprintf("%d-", m["weight"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["subnet_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t-", m["admin_state_up"].(bool)))
+
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,265 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceWhitelistV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWhitelistV2Create,
+		Read:   resourceWhitelistV2Read,
+		Update: resourceWhitelistV2Update,
+		Delete: resourceWhitelistV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceWhitelistV2ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enable_whitelist": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"whitelist": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPWithOptionalMask,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceWhitelistV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	enableWhitelist := d.Get("enable_whitelist").(bool)
+	whitelist := resourceWhitelistV2Addresses(d)
+	createOpts := LBWhitelistV2Opts{
+		TenantID:        d.Get("tenant_id").(string),
+		ListenerID:      d.Get("listener_id").(string),
+		EnableWhitelist: &enableWhitelist,
+		Whitelist:       &whitelist,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutCreate)
+	listenerID := createOpts.ListenerID
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to create whitelist")
+	var wl *LBWhitelistV2
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		wl, err = lbV2CreateWhitelist(networkingClient, createOpts)
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error creating whitelist: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(wl.ID)
+
+	return resourceWhitelistV2Read(d, meta)
+}
+
+func resourceWhitelistV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	wl, err := lbV2GetWhitelist(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "whitelist")
+	}
+
+	log.Printf("[DEBUG] Retrieved whitelist %s: %#v", d.Id(), wl)
+
+	whitelist := []string{}
+	for _, address := range strings.Split(wl.Whitelist, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			whitelist = append(whitelist, address)
+		}
+	}
+
+	d.Set("tenant_id", wl.TenantID)
+	d.Set("listener_id", wl.ListenerID)
+	d.Set("enable_whitelist", wl.EnableWhitelist)
+	if err := d.Set("whitelist", whitelist); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving whitelist to state for whitelist (%s): %s", d.Id(), err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceWhitelistV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var updateOpts LBWhitelistV2Opts
+	if d.HasChange("enable_whitelist") {
+		enableWhitelist := d.Get("enable_whitelist").(bool)
+		updateOpts.EnableWhitelist = &enableWhitelist
+	}
+	if d.HasChange("whitelist") {
+		whitelist := resourceWhitelistV2Addresses(d)
+		updateOpts.Whitelist = &whitelist
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating whitelist %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2UpdateWhitelist(networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update whitelist %s: %s", d.Id(), err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceWhitelistV2Read(d, meta)
+}
+
+func resourceWhitelistV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutDelete)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to delete whitelist %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2DeleteWhitelist(networkingClient, d.Id())
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return CheckDeleted(d, err, "whitelist")
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceWhitelistV2ImportState looks up the whitelist of the listener whose
+// ID is imported. IDs that do not belong to a listener with a whitelist are
+// imported as whitelist IDs.
+func resourceWhitelistV2ImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	whitelists, err := lbV2ListWhitelists(networkingClient, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve whitelists of listener %s: %s", d.Id(), err)
+	}
+
+	if len(whitelists) > 0 {
+		log.Printf("[DEBUG] Importing whitelist %s of listener %s", whitelists[0].ID, d.Id())
+		d.SetId(whitelists[0].ID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceWhitelistV2Addresses(d *schema.ResourceData) string {
+	var whitelist []string
+	for _, address := range d.Get("whitelist").(*schema.Set).List() {
+		whitelist = append(whitelist, address.(string))
+	}
+	return strings.Join(whitelist, ",")
+}
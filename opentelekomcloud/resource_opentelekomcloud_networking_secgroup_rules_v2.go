@@ -0,0 +1,368 @@
+package opentelekomcloud
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+)
+
+func resourceNetworkingSecGroupRulesV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingSecGroupRulesV2Create,
+		Read:   resourceNetworkingSecGroupRulesV2Read,
+		Update: resourceNetworkingSecGroupRulesV2Update,
+		Delete: resourceNetworkingSecGroupRulesV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delete_default_rules": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rule": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direction": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"ingress", "egress"})
+							},
+						},
+						"ethertype": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"IPv4", "IPv6"})
+							},
+						},
+						"protocol": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"port_range_min": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"port_range_max": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"remote_ip_prefix": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							StateFunc: func(v interface{}) string {
+								return strings.ToLower(v.(string))
+							},
+						},
+						"remote_group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				Set: secgroupRulesV2Hash,
+			},
+		},
+	}
+}
+
+func resourceNetworkingSecGroupRulesV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if err := checkSecGroupRulesV2ForErrors(d); err != nil {
+		return err
+	}
+
+	secGroupID := d.Get("security_group_id").(string)
+	if _, err := groups.Get(networkingClient, secGroupID).Extract(); err != nil {
+		return fmt.Errorf("Error retrieving OpenTelekomCloud Security Group %s: %s", secGroupID, err)
+	}
+
+	existing, err := listSecGroupRulesV2(networkingClient, secGroupID)
+	if err != nil {
+		return err
+	}
+
+	desired := d.Get("rule").(*schema.Set)
+
+	// Rules which are already part of the group, e.g. the default egress
+	// rules, are adopted instead of being created a second time.
+	existingHashes := make(map[int]bool)
+	for _, rule := range existing {
+		m := secGroupRuleV2ToMap(rule)
+		if desired.Contains(m) {
+			existingHashes[secgroupRulesV2Hash(m)] = true
+			continue
+		}
+
+		if d.Get("delete_default_rules").(bool) && isDefaultSecGroupRuleV2(rule) {
+			log.Printf("[DEBUG] Deleting default OpenTelekomCloud Security Group Rule %s", rule.ID)
+			if err := deleteSecGroupRuleV2(networkingClient, rule.ID); err != nil {
+				return fmt.Errorf(
+					"There was a problem deleting a default security group rule: %s", err)
+			}
+		}
+	}
+
+	// The ID is set before the rules are created, so that rules created
+	// before a failure are picked up by the next refresh.
+	d.SetId(secGroupID)
+
+	for _, raw := range desired.List() {
+		if existingHashes[secgroupRulesV2Hash(raw)] {
+			continue
+		}
+		if err := createSecGroupRuleV2(networkingClient, secGroupID, raw.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetworkingSecGroupRulesV2Read(d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Retrieve information about security group rules: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if _, err := groups.Get(networkingClient, d.Id()).Extract(); err != nil {
+		return CheckDeleted(d, err, "OpenTelekomCloud Neutron Security group")
+	}
+
+	existing, err := listSecGroupRulesV2(networkingClient, d.Id())
+	if err != nil {
+		return err
+	}
+
+	configured := d.Get("rule").(*schema.Set)
+	deleteDefaultRules := d.Get("delete_default_rules").(bool)
+
+	// Every rule of the group is reported so that rules added outside of
+	// Terraform show up as drift. The default egress rules are the exception
+	// when they were left in place on purpose.
+	var ruleList []map[string]interface{}
+	for _, rule := range existing {
+		m := secGroupRuleV2ToMap(rule)
+		if !deleteDefaultRules && isDefaultSecGroupRuleV2(rule) && !configured.Contains(m) {
+			continue
+		}
+		ruleList = append(ruleList, m)
+	}
+
+	log.Printf("[DEBUG] Read OpenTelekomCloud Security Group %s rules: %#v", d.Id(), ruleList)
+	if err := d.Set("rule", ruleList); err != nil {
+		return fmt.Errorf("Error saving rule to state for OpenTelekomCloud Security Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("security_group_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if d.HasChange("rule") {
+		if err := checkSecGroupRulesV2ForErrors(d); err != nil {
+			return err
+		}
+
+		o, n := d.GetChange("rule")
+		oldRules := o.(*schema.Set)
+		newRules := n.(*schema.Set)
+		removed := oldRules.Difference(newRules)
+		added := newRules.Difference(oldRules)
+
+		existing, err := listSecGroupRulesV2(networkingClient, d.Id())
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range existing {
+			if !removed.Contains(secGroupRuleV2ToMap(rule)) {
+				continue
+			}
+			log.Printf("[DEBUG] Deleting OpenTelekomCloud Security Group Rule %s", rule.ID)
+			if err := deleteSecGroupRuleV2(networkingClient, rule.ID); err != nil {
+				return fmt.Errorf("Error deleting OpenTelekomCloud Security Group Rule %s: %s", rule.ID, err)
+			}
+		}
+
+		for _, raw := range added.List() {
+			if err := createSecGroupRuleV2(networkingClient, d.Id(), raw.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceNetworkingSecGroupRulesV2Read(d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Destroy security group rules: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	existing, err := listSecGroupRulesV2(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "OpenTelekomCloud Neutron Security group")
+	}
+
+	managed := d.Get("rule").(*schema.Set)
+	for _, rule := range existing {
+		if !managed.Contains(secGroupRuleV2ToMap(rule)) {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting OpenTelekomCloud Security Group Rule %s", rule.ID)
+		if err := deleteSecGroupRuleV2(networkingClient, rule.ID); err != nil {
+			return fmt.Errorf("Error deleting OpenTelekomCloud Security Group Rule %s: %s", rule.ID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func checkSecGroupRulesV2ForErrors(d *schema.ResourceData) error {
+	for _, raw := range d.Get("rule").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		if m["protocol"].(string) == "" {
+			if m["port_range_min"].(int) != 0 || m["port_range_max"].(int) != 0 {
+				return fmt.Errorf("A protocol must be specified when using port_range_min and port_range_max")
+			}
+		}
+		if m["remote_ip_prefix"].(string) != "" && m["remote_group_id"].(string) != "" {
+			return fmt.Errorf("Only one of remote_ip_prefix and remote_group_id can be set in a rule")
+		}
+	}
+
+	return nil
+}
+
+func listSecGroupRulesV2(networkingClient *gophercloud.ServiceClient, secGroupID string) ([]rules.SecGroupRule, error) {
+	allPages, err := rules.List(networkingClient, rules.ListOpts{SecGroupID: secGroupID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allRules, err := rules.ExtractRules(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve OpenTelekomCloud Security Group Rules: %s", err)
+	}
+
+	return allRules, nil
+}
+
+func createSecGroupRuleV2(networkingClient *gophercloud.ServiceClient, secGroupID string, m map[string]interface{}) error {
+	opts := rules.CreateOpts{
+		SecGroupID:     secGroupID,
+		Direction:      resourceNetworkingSecGroupRuleV2DetermineDirection(m["direction"].(string)),
+		EtherType:      resourceNetworkingSecGroupRuleV2DetermineEtherType(m["ethertype"].(string)),
+		PortRangeMin:   m["port_range_min"].(int),
+		PortRangeMax:   m["port_range_max"].(int),
+		RemoteGroupID:  m["remote_group_id"].(string),
+		RemoteIPPrefix: m["remote_ip_prefix"].(string),
+	}
+
+	if v := m["protocol"].(string); v != "" {
+		opts.Protocol = resourceNetworkingSecGroupRuleV2DetermineProtocol(v)
+	}
+
+	log.Printf("[DEBUG] Create OpenTelekomCloud Neutron security group rule: %#v", opts)
+	rule, err := rules.Create(networkingClient, opts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud Security Group Rule: %s", err)
+	}
+
+	log.Printf("[DEBUG] OpenTelekomCloud Neutron Security Group Rule created: %#v", rule)
+	return nil
+}
+
+func deleteSecGroupRuleV2(networkingClient *gophercloud.ServiceClient, id string) error {
+	err := rules.Delete(networkingClient, id).ExtractErr()
+	if _, ok := err.(gophercloud.ErrDefault404); ok {
+		return nil
+	}
+	return err
+}
+
+// isDefaultSecGroupRuleV2 reports whether the rule looks like one of the
+// allow-all egress rules which are added to every new security group.
+func isDefaultSecGroupRuleV2(rule rules.SecGroupRule) bool {
+	return rule.Direction == "egress" && rule.Protocol == "" &&
+		rule.PortRangeMin == 0 && rule.PortRangeMax == 0 &&
+		rule.RemoteIPPrefix == "" && rule.RemoteGroupID == ""
+}
+
+func secGroupRuleV2ToMap(rule rules.SecGroupRule) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               rule.ID,
+		"direction":        rule.Direction,
+		"ethertype":        rule.EtherType,
+		"protocol":         rule.Protocol,
+		"port_range_min":   rule.PortRangeMin,
+		"port_range_max":   rule.PortRangeMax,
+		"remote_ip_prefix": rule.RemoteIPPrefix,
+		"remote_group_id":  rule.RemoteGroupID,
+	}
+}
+
+func secgroupRulesV2Hash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["direction"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["ethertype"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["protocol"].(string))))
+	buf.WriteString(fmt.Sprintf("%d-", m["port_range_min"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["port_range_max"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["remote_ip_prefix"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", m["remote_group_id"].(string)))
+
+	return hashcode.String(buf.String())
+}
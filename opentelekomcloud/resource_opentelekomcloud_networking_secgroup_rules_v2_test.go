@@ -0,0 +1,223 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+)
+
+func TestAccNetworkingV2SecGroupRules_basic(t *testing.T) {
+	var secgroup_1 groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2SecGroupRules_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"opentelekomcloud_networking_secgroup_v2.secgroup_1", &secgroup_1),
+					testAccCheckNetworkingV2SecGroupRulesCount(&secgroup_1, 4),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_networking_secgroup_rules_v2.rules_1", "rule.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2SecGroupRules_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"opentelekomcloud_networking_secgroup_v2.secgroup_1", &secgroup_1),
+					testAccCheckNetworkingV2SecGroupRulesCount(&secgroup_1, 5),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_networking_secgroup_rules_v2.rules_1", "rule.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2SecGroupRules_deleteDefaultRules(t *testing.T) {
+	var secgroup_1 groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2SecGroupRules_deleteDefaultRules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"opentelekomcloud_networking_secgroup_v2.secgroup_1", &secgroup_1),
+					testAccCheckNetworkingV2SecGroupRulesCount(&secgroup_1, 1),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_networking_secgroup_rules_v2.rules_1", "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2SecGroupRules_drift(t *testing.T) {
+	var secgroup_1 groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2SecGroupRules_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"opentelekomcloud_networking_secgroup_v2.secgroup_1", &secgroup_1),
+					testAccCheckNetworkingV2SecGroupRulesAddOutOfBand(&secgroup_1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2SecGroupRules_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupRulesCount(&secgroup_1, 4),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SecGroupRulesCount(secgroup *groups.SecGroup, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		allRules, err := listSecGroupRulesV2(networkingClient, secgroup.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(allRules) != count {
+			return fmt.Errorf("Expected %d security group rules, got %d", count, len(allRules))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2SecGroupRulesAddOutOfBand(secgroup *groups.SecGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		opts := rules.CreateOpts{
+			SecGroupID:     secgroup.ID,
+			Direction:      rules.DirIngress,
+			EtherType:      rules.EtherType4,
+			Protocol:       rules.ProtocolTCP,
+			PortRangeMin:   8080,
+			PortRangeMax:   8080,
+			RemoteIPPrefix: "10.0.0.0/8",
+		}
+
+		_, err = rules.Create(networkingClient, opts).Extract()
+		return err
+	}
+}
+
+const testAccNetworkingV2SecGroupRules_basic = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = "${opentelekomcloud_networking_secgroup_v2.secgroup_1.id}"
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 22
+    port_range_max = 22
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 80
+    port_range_max = 80
+    remote_group_id = "${opentelekomcloud_networking_secgroup_v2.secgroup_1.id}"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRules_update = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = "${opentelekomcloud_networking_secgroup_v2.secgroup_1.id}"
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 22
+    port_range_max = 22
+    remote_ip_prefix = "192.168.0.0/16"
+  }
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 443
+    port_range_max = 443
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "icmp"
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRules_deleteDefaultRules = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = "${opentelekomcloud_networking_secgroup_v2.secgroup_1.id}"
+  delete_default_rules = true
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 22
+    port_range_max = 22
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`
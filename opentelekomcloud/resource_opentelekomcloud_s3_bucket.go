@@ -265,10 +265,201 @@ func resourceS3Bucket() *schema.Resource {
 								},
 							},
 						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      transitionHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleExpirationDays,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateS3BucketTransitionStorageClass,
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      transitionHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleExpirationDays,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateS3BucketTransitionStorageClass,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"apply_server_side_encryption_by_default": {
+										Type:     schema.TypeList,
+										MaxItems: 1,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_master_key_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sse_algorithm": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+														return ValidateStringList(v, k, []string{"aws:kms"})
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 
+			"replication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rules": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      rulesHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleRuleId,
+									},
+									"destination": {
+										Type:     schema.TypeSet,
+										MaxItems: 1,
+										MinItems: 1,
+										Required: true,
+										Set:      destinationHash,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"storage_class": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+														return ValidateStringList(v, k, []string{"STANDARD", "WARM", "COLD"})
+													},
+												},
+											},
+										},
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+											return ValidateStringList(v, k, []string{
+												s3.ReplicationRuleStatusEnabled, s3.ReplicationRuleStatusDisabled,
+											})
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"topic_urn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"filter_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filter_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateS3StorageClass,
+			},
+
+			"quota": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateS3BucketQuota,
+			},
+
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -397,6 +588,37 @@ func resourceS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("server_side_encryption_configuration") {
+		if err := resourceS3BucketServerSideEncryptionConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("replication_configuration") {
+		if err := resourceS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("notification") {
+		if err := resourceS3BucketNotificationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	// The storage class is computed, so it is only set if configured
+	if _, ok := d.GetOk("storage_class"); ok && d.HasChange("storage_class") {
+		if err := resourceS3BucketStorageClassUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("quota") {
+		if err := resourceS3BucketQuotaUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceS3BucketRead(d, meta)
 }
 
@@ -701,7 +923,7 @@ func resourceS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 						t["days"] = int(*v.Days)
 					}
 					if v.StorageClass != nil {
-						t["storage_class"] = *v.StorageClass
+						t["storage_class"] = s3StorageClassName(*v.StorageClass)
 					}
 					transitions = append(transitions, t)
 				}
@@ -716,7 +938,7 @@ func resourceS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 						t["days"] = int(*v.NoncurrentDays)
 					}
 					if v.StorageClass != nil {
-						t["storage_class"] = *v.StorageClass
+						t["storage_class"] = s3StorageClassName(*v.StorageClass)
 					}
 					transitions = append(transitions, t)
 				}
@@ -731,6 +953,81 @@ func resourceS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Read the bucket encryption configuration
+	encryptionResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3GetBucketEncryption(s3conn, d.Id())
+	})
+	if err != nil {
+		// An S3 Bucket might not have an encryption configuration set.
+		if awsError, ok := err.(awserr.RequestFailure); !ok || awsError.StatusCode() != 404 {
+			return fmt.Errorf("error getting S3 Bucket encryption: %s", err)
+		}
+		log.Printf("[WARN] S3 bucket: %s, no encryption configuration could be found.", d.Id())
+	}
+	encryption, _ := encryptionResponse.(*s3ServerSideEncryptionConfiguration)
+	log.Printf("[DEBUG] S3 Bucket: %s, encryption: %v", d.Id(), encryption)
+	if err := d.Set("server_side_encryption_configuration", flattenS3ServerSideEncryptionConfiguration(encryption)); err != nil {
+		return fmt.Errorf("error setting server_side_encryption_configuration: %s", err)
+	}
+
+	// Read the replication configuration
+	replicationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+	if err != nil {
+		// An S3 Bucket might not have a replication configuration set.
+		if awsError, ok := err.(awserr.RequestFailure); !ok || awsError.StatusCode() != 404 {
+			return fmt.Errorf("error getting S3 Bucket replication: %s", err)
+		}
+	}
+	replication := replicationResponse.(*s3.GetBucketReplicationOutput)
+	log.Printf("[DEBUG] S3 Bucket: %s, read replication configuration: %v", d.Id(), replication)
+	if err := d.Set("replication_configuration", flattenS3ReplicationConfiguration(replication.ReplicationConfiguration)); err != nil {
+		return fmt.Errorf("error setting replication_configuration: %s", err)
+	}
+
+	// Read the notification configuration
+	notificationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket notification configuration: %s", err)
+	}
+	notification := notificationResponse.(*s3.NotificationConfiguration)
+	log.Printf("[DEBUG] S3 Bucket: %s, read notification configuration: %v", d.Id(), notification)
+	if err := d.Set("notification", flattenS3TopicConfigurations(notification.TopicConfigurations)); err != nil {
+		return fmt.Errorf("error setting notification: %s", err)
+	}
+
+	// Read the default storage class
+	storageClassResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3GetBucketStorageClass(s3conn, d.Id())
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket storage class: %s", err)
+	}
+	storageClass := storageClassResponse.(string)
+	log.Printf("[DEBUG] S3 Bucket: %s, storage class: %s", d.Id(), storageClass)
+	if storageClass == "" {
+		storageClass = "STANDARD"
+	}
+	d.Set("storage_class", storageClass)
+
+	// Read the quota
+	quotaResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3GetBucketQuota(s3conn, d.Id())
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket quota: %s", err)
+	}
+	quota := quotaResponse.(int64)
+	log.Printf("[DEBUG] S3 Bucket: %s, quota: %d", d.Id(), quota)
+	d.Set("quota", int(quota))
+
 	// Add the region as an attribute
 
 	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
@@ -1304,6 +1601,40 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 			}
 		}
 
+		// Transitions
+		transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.transition", i)).(*schema.Set).List()
+		for _, transition := range transitions {
+			tr := transition.(map[string]interface{})
+			t := &s3.Transition{
+				StorageClass: aws.String(s3StorageClass(tr["storage_class"].(string))),
+			}
+
+			if val, ok := tr["date"].(string); ok && val != "" {
+				date, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", val))
+				if err != nil {
+					return fmt.Errorf("Error Parsing Swift S3 Bucket Lifecycle Transition Date: %s", err.Error())
+				}
+				t.Date = aws.Time(date)
+			} else if val, ok := tr["days"].(int); ok && val > 0 {
+				t.Days = aws.Int64(int64(val))
+			}
+			rule.Transitions = append(rule.Transitions, t)
+		}
+
+		// NoncurrentVersionTransitions
+		nc_transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_transition", i)).(*schema.Set).List()
+		for _, transition := range nc_transitions {
+			tr := transition.(map[string]interface{})
+			t := &s3.NoncurrentVersionTransition{
+				StorageClass: aws.String(s3StorageClass(tr["storage_class"].(string))),
+			}
+
+			if val, ok := tr["days"].(int); ok && val > 0 {
+				t.NoncurrentDays = aws.Int64(int64(val))
+			}
+			rule.NoncurrentVersionTransitions = append(rule.NoncurrentVersionTransitions, t)
+		}
+
 		rules = append(rules, rule)
 	}
 
@@ -1329,6 +1660,300 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 	return nil
 }
 
+func resourceS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
+
+	if len(serverSideEncryptionConfiguration) == 0 {
+		log.Printf("[DEBUG] Delete server side encryption configuration: %#v", serverSideEncryptionConfiguration)
+		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return nil, s3DeleteBucketEncryption(s3conn, bucket)
+		})
+		if err != nil {
+			return fmt.Errorf("error removing S3 bucket server side encryption: %s", err)
+		}
+		return nil
+	}
+
+	c := serverSideEncryptionConfiguration[0].(map[string]interface{})
+	rc := &s3ServerSideEncryptionConfiguration{}
+
+	for _, v := range c["rule"].([]interface{}) {
+		rule := v.(map[string]interface{})
+		for _, v := range rule["apply_server_side_encryption_by_default"].([]interface{}) {
+			sse := v.(map[string]interface{})
+			byDefault := &s3ServerSideEncryptionByDefault{
+				SSEAlgorithm: aws.String(sse["sse_algorithm"].(string)),
+			}
+			if kmsKeyID := sse["kms_master_key_id"].(string); kmsKeyID != "" {
+				byDefault.KMSMasterKeyID = aws.String(kmsKeyID)
+			}
+			rc.Rules = append(rc.Rules, &s3ServerSideEncryptionRule{
+				ApplyServerSideEncryptionByDefault: byDefault,
+			})
+		}
+	}
+
+	log.Printf("[DEBUG] S3 put bucket server side encryption configuration: %#v", rc)
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return nil, s3PutBucketEncryption(s3conn, bucket, rc)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 server side encryption configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceS3BucketStorageClassUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	storageClass := d.Get("storage_class").(string)
+
+	log.Printf("[DEBUG] S3 put bucket storage class: %s", storageClass)
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return nil, s3PutBucketStorageClass(s3conn, bucket, storageClass)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 storage class: %s", err)
+	}
+
+	return nil
+}
+
+func resourceS3BucketQuotaUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	quota := int64(d.Get("quota").(int))
+
+	log.Printf("[DEBUG] S3 put bucket quota: %d", quota)
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return nil, s3PutBucketQuota(s3conn, bucket, quota)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 quota: %s", err)
+	}
+
+	return nil
+}
+
+func resourceS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	replicationConfiguration := d.Get("replication_configuration").([]interface{})
+
+	if len(replicationConfiguration) == 0 {
+		i := &s3.DeleteBucketReplicationInput{
+			Bucket: aws.String(bucket),
+		}
+
+		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+			if _, err := s3conn.DeleteBucketReplication(i); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error removing S3 bucket replication: %s", err)
+		}
+		return nil
+	}
+
+	c := replicationConfiguration[0].(map[string]interface{})
+	rc := &s3.ReplicationConfiguration{
+		Role: aws.String(c["role"].(string)),
+	}
+
+	for _, v := range c["rules"].(*schema.Set).List() {
+		rr := v.(map[string]interface{})
+		rcRule := &s3.ReplicationRule{
+			Prefix: aws.String(rr["prefix"].(string)),
+			Status: aws.String(rr["status"].(string)),
+		}
+
+		if rrid, ok := rr["id"].(string); ok && rrid != "" {
+			rcRule.ID = aws.String(rrid)
+		}
+
+		for _, v := range rr["destination"].(*schema.Set).List() {
+			bd := v.(map[string]interface{})
+			rcRule.Destination = &s3.Destination{
+				Bucket: aws.String(s3BucketArn(bd["bucket"].(string))),
+			}
+			if storageClass, ok := bd["storage_class"].(string); ok && storageClass != "" {
+				rcRule.Destination.StorageClass = aws.String(s3StorageClass(storageClass))
+			}
+		}
+
+		rc.Rules = append(rc.Rules, rcRule)
+	}
+
+	i := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(bucket),
+		ReplicationConfiguration: rc,
+	}
+	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
+
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketReplication(i)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceS3BucketNotificationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	notifications := d.Get("notification").([]interface{})
+
+	topicConfigurations := make([]*s3.TopicConfiguration, 0, len(notifications))
+	for i, v := range notifications {
+		n := v.(map[string]interface{})
+		tc := &s3.TopicConfiguration{
+			TopicArn: aws.String(n["topic_urn"].(string)),
+			Events:   expandStringList(n["events"].(*schema.Set).List()),
+		}
+
+		if id, ok := n["id"].(string); ok && id != "" {
+			tc.Id = aws.String(id)
+		} else {
+			tc.Id = aws.String(resource.PrefixedUniqueId("tf-s3-notification-"))
+		}
+
+		var filterRules []*s3.FilterRule
+		if prefix := n["filter_prefix"].(string); prefix != "" {
+			filterRules = append(filterRules, &s3.FilterRule{
+				Name:  aws.String(s3.FilterRuleNamePrefix),
+				Value: aws.String(prefix),
+			})
+		}
+		if suffix := n["filter_suffix"].(string); suffix != "" {
+			filterRules = append(filterRules, &s3.FilterRule{
+				Name:  aws.String(s3.FilterRuleNameSuffix),
+				Value: aws.String(suffix),
+			})
+		}
+		if len(filterRules) > 0 {
+			tc.Filter = &s3.NotificationConfigurationFilter{
+				Key: &s3.KeyFilter{FilterRules: filterRules},
+			}
+		}
+
+		log.Printf("[DEBUG] S3 bucket: %s, notification %d: %#v", bucket, i, tc)
+		topicConfigurations = append(topicConfigurations, tc)
+	}
+
+	// An empty configuration removes all notifications of the bucket.
+	i := &s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+		NotificationConfiguration: &s3.NotificationConfiguration{
+			TopicConfigurations: topicConfigurations,
+		},
+	}
+	log.Printf("[DEBUG] S3 put bucket notification configuration: %#v", i)
+
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketNotificationConfiguration(i)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 notification configuration: %s", err)
+	}
+
+	return nil
+}
+
+func flattenS3ServerSideEncryptionConfiguration(c *s3ServerSideEncryptionConfiguration) []map[string]interface{} {
+	var encryptionConfiguration []map[string]interface{}
+	if c == nil || len(c.Rules) == 0 {
+		return encryptionConfiguration
+	}
+
+	rules := make([]interface{}, 0, len(c.Rules))
+	for _, v := range c.Rules {
+		if v.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+		sse := map[string]interface{}{
+			"sse_algorithm":     aws.StringValue(v.ApplyServerSideEncryptionByDefault.SSEAlgorithm),
+			"kms_master_key_id": aws.StringValue(v.ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
+		}
+		rules = append(rules, map[string]interface{}{
+			"apply_server_side_encryption_by_default": []interface{}{sse},
+		})
+	}
+
+	encryptionConfiguration = append(encryptionConfiguration, map[string]interface{}{
+		"rule": rules,
+	})
+	return encryptionConfiguration
+}
+
+func flattenS3ReplicationConfiguration(r *s3.ReplicationConfiguration) []map[string]interface{} {
+	replicationConfiguration := make([]map[string]interface{}, 0, 1)
+	if r == nil {
+		return replicationConfiguration
+	}
+
+	m := make(map[string]interface{})
+	if r.Role != nil && *r.Role != "" {
+		m["role"] = *r.Role
+	}
+
+	rules := make([]interface{}, 0, len(r.Rules))
+	for _, v := range r.Rules {
+		t := make(map[string]interface{})
+		if v.Destination != nil {
+			rd := make(map[string]interface{})
+			if v.Destination.Bucket != nil {
+				rd["bucket"] = s3BucketNameFromArn(*v.Destination.Bucket)
+			}
+			if v.Destination.StorageClass != nil {
+				rd["storage_class"] = s3StorageClassName(*v.Destination.StorageClass)
+			}
+			t["destination"] = schema.NewSet(destinationHash, []interface{}{rd})
+		}
+
+		if v.ID != nil {
+			t["id"] = *v.ID
+		}
+		if v.Prefix != nil {
+			t["prefix"] = *v.Prefix
+		}
+		if v.Status != nil {
+			t["status"] = *v.Status
+		}
+		rules = append(rules, t)
+	}
+	m["rules"] = schema.NewSet(rulesHash, rules)
+
+	replicationConfiguration = append(replicationConfiguration, m)
+	return replicationConfiguration
+}
+
+func flattenS3TopicConfigurations(configurations []*s3.TopicConfiguration) []map[string]interface{} {
+	notifications := make([]map[string]interface{}, 0, len(configurations))
+	for _, tc := range configurations {
+		n := map[string]interface{}{
+			"id":        aws.StringValue(tc.Id),
+			"topic_urn": aws.StringValue(tc.TopicArn),
+			"events":    schema.NewSet(schema.HashString, flattenStringList(tc.Events)),
+		}
+
+		if tc.Filter != nil && tc.Filter.Key != nil {
+			for _, f := range tc.Filter.Key.FilterRules {
+				switch strings.ToLower(aws.StringValue(f.Name)) {
+				case s3.FilterRuleNamePrefix:
+					n["filter_prefix"] = aws.StringValue(f.Value)
+				case s3.FilterRuleNameSuffix:
+					n["filter_suffix"] = aws.StringValue(f.Value)
+				}
+			}
+		}
+
+		notifications = append(notifications, n)
+	}
+	return notifications
+}
+
 func normalizeRoutingRules(w []*s3.RoutingRule) (string, error) {
 	withNulls, err := json.Marshal(w)
 	if err != nil {
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	//"net/url"
 	"os"
 	"sort"
 	"strings"
@@ -17,13 +16,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	//"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 )
 
 func resourceS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceS3BucketObjectPut,
 		Read:   resourceS3BucketObjectRead,
-		Update: resourceS3BucketObjectPut,
+		Update: resourceS3BucketObjectUpdate,
 		Delete: resourceS3BucketObjectDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -116,6 +116,26 @@ func resourceS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateS3StorageClass,
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateS3BucketObjectMetadata,
+			},
+
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3MultipartThreshold,
+				ValidateFunc: validateS3MultipartThreshold,
+			},
 		},
 	}
 }
@@ -139,6 +159,7 @@ func resourceS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
 		}
+		defer file.Close()
 
 		body = file
 	} else if v, ok := d.GetOk("content"); ok {
@@ -151,7 +172,7 @@ func resourceS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	putInput := &s3.PutObjectInput{
+	putInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(d.Get("acl").(string)),
@@ -190,19 +211,130 @@ func resourceS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		putInput.SSEKMSKeyId = aws.String(v.(string))
 	}
 
-	resp, err := s3conn.PutObject(putInput)
+	if v, ok := d.GetOk("storage_class"); ok {
+		putInput.StorageClass = aws.String(s3StorageClass(v.(string)))
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		putInput.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	threshold := int64(d.Get("multipart_threshold").(int))
+	resp, err := s3UploadObject(s3conn, putInput, threshold)
 	if err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
-	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
-
-	d.Set("version_id", resp.VersionId)
+	d.Set("version_id", resp.VersionID)
 	d.SetId(key)
 	return resourceS3BucketObjectRead(d, meta)
 }
 
+// resourceS3BucketObjectUpdate uploads the object again if its content or
+// encryption changed. Otherwise it copies the object onto itself with the
+// new ACL, headers, metadata and storage class. Objects too large for a
+// single copy are copied in parts.
+func resourceS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("source") || d.HasChange("content") || d.HasChange("etag") ||
+		d.HasChange("server_side_encryption") || d.HasChange("sse_kms_key_id") {
+		return resourceS3BucketObjectPut(d, meta)
+	}
+
+	changed := false
+	for _, k := range []string{
+		"acl", "cache_control", "content_disposition", "content_encoding", "content_language",
+		"content_type", "website_redirect", "storage_class", "metadata",
+	} {
+		if d.HasChange(k) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return resourceS3BucketObjectRead(d, meta)
+	}
+
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	head, err := s3conn.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading S3 bucket object (%s): %s", key, err)
+	}
+	copyInput := &s3.CopyObjectInput{
+		Bucket:            aws.String(bucket),
+		Key:               aws.String(key),
+		CopySource:        aws.String(s3CopySource(bucket, key)),
+		MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+		ACL:               aws.String(d.Get("acl").(string)),
+		Metadata:          stringMapToPointers(d.Get("metadata").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		copyInput.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_type"); ok {
+		copyInput.ContentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_encoding"); ok {
+		copyInput.ContentEncoding = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_language"); ok {
+		copyInput.ContentLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_disposition"); ok {
+		copyInput.ContentDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		copyInput.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("website_redirect"); ok {
+		copyInput.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sse_kms_key_id"); ok {
+		copyInput.SSEKMSKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		copyInput.StorageClass = aws.String(s3StorageClass(v.(string)))
+	}
+
+	var versionID *string
+	if size := aws.Int64Value(head.ContentLength); size > s3CopyObjectLimit {
+		log.Printf("[DEBUG] Copying S3 bucket object in place in parts: %s", copyInput)
+		versionID, err = s3CopyObjectMultipart(s3conn, copyInput, size)
+	} else {
+		log.Printf("[DEBUG] Copying S3 bucket object in place: %s", copyInput)
+		var resp *s3.CopyObjectOutput
+		resp, err = s3conn.CopyObject(copyInput)
+		if err == nil {
+			versionID = resp.VersionId
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error copying object in S3 bucket (%s): %s", bucket, err)
+	}
+
+	d.Set("version_id", versionID)
+	return resourceS3BucketObjectRead(d, meta)
+}
+
 func resourceS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	s3conn, err := config.computeS3conn(GetRegion(d, config))
@@ -242,7 +374,32 @@ func resourceS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
 	d.Set("sse_kms_key_id", resp.SSEKMSKeyId)
 
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
+	// HeadObject only returns the storage class of objects that are not in
+	// the STANDARD storage class.
+	storageClass := "STANDARD"
+	if resp.StorageClass != nil {
+		storageClass = s3StorageClassName(*resp.StorageClass)
+	}
+	d.Set("storage_class", storageClass)
+
+	// The user metadata keys are returned canonicalized as HTTP headers.
+	metadata := make(map[string]string, len(resp.Metadata))
+	for k, v := range resp.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return fmt.Errorf("Error setting metadata: %s", err)
+	}
+
+	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+	etag := strings.Trim(*resp.ETag, `"`)
+	// The ETag of an object uploaded in parts is not its MD5, so a configured
+	// MD5 is kept rather than shown as changed on every plan.
+	if v, ok := d.GetOk("etag"); ok && s3IsMultipartETag(etag) && !s3IsMultipartETag(v.(string)) {
+		log.Printf("[DEBUG] Keeping etag %s of S3 bucket object %s uploaded in parts", v.(string), key)
+	} else {
+		d.Set("etag", etag)
+	}
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -16,6 +17,36 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
+func TestS3CopySource(t *testing.T) {
+	var testCases = []struct {
+		Bucket   string
+		Key      string
+		Expected string
+	}{
+		{
+			Bucket:   "bucket",
+			Key:      "dir/file.txt",
+			Expected: "bucket/dir/file.txt",
+		},
+		{
+			Bucket:   "bucket",
+			Key:      "my dir/a+b.txt",
+			Expected: "bucket/my%20dir/a+b.txt",
+		},
+		{
+			Bucket:   "bucket",
+			Key:      "100%/?x",
+			Expected: "bucket/100%25/%3Fx",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := s3CopySource(tc.Bucket, tc.Key); got != tc.Expected {
+			t.Fatalf("Expected copy source of %q to be %q, got %q", tc.Key, tc.Expected, got)
+		}
+	}
+}
+
 // PASS
 func TestAccS3BucketObject_source(t *testing.T) {
 	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-source")
@@ -190,6 +221,71 @@ func TestAccS3BucketObject_updatesWithVersioning(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// 6 MiB, just above the smallest threshold, are uploaded in two parts.
+	err = ioutil.WriteFile(tmpFile.Name(), make([]byte, 6*1024*1024), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccS3BucketObjectConfig_multipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketObjectExists("opentelekomcloud_s3_bucket_object.object", &obj),
+					resource.TestMatchResourceAttr(
+						"opentelekomcloud_s3_bucket_object.object", "etag", regexp.MustCompile("-2$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObject_storageClass(t *testing.T) {
+	rInt := acctest.RandInt()
+	var originalObj, modifiedObj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccS3BucketObjectConfig_storageClass(rInt, "STANDARD", "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketObjectExists("opentelekomcloud_s3_bucket_object.object", &originalObj),
+					resource.TestCheckResourceAttr("opentelekomcloud_s3_bucket_object.object", "storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr("opentelekomcloud_s3_bucket_object.object", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("opentelekomcloud_s3_bucket_object.object", "metadata.owner", "initial"),
+					resource.TestCheckResourceAttr("opentelekomcloud_s3_bucket_object.object", "etag", "f8b541d8c22738aed8af035deaba7a84"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccS3BucketObjectConfig_storageClass(rInt, "WARM", "modified"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketObjectExists("opentelekomcloud_s3_bucket_object.object", &modifiedObj),
+					resource.TestCheckResourceAttr("opentelekomcloud_s3_bucket_object.object", "storage_class", "WARM"),
+					resource.TestCheckResourceAttr("opentelekomcloud_s3_bucket_object.object", "metadata.owner", "modified"),
+					resource.TestCheckResourceAttr("opentelekomcloud_s3_bucket_object.object", "etag", "f8b541d8c22738aed8af035deaba7a84"),
+					testAccCheckS3BucketObjectVersionIdDiffers(&originalObj, &modifiedObj),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckS3BucketObjectVersionIdDiffers(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if first.VersionId == nil {
@@ -435,6 +531,33 @@ func TestResourceS3BucketObjectStorageClass_validation(t *testing.T) {
 	}
 }
 
+func TestResourceS3BucketObjectMultipartThreshold_validation(t *testing.T) {
+	var testCases = []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    1024,
+			ErrCount: 1,
+		},
+		{
+			Value:    5 * 1024 * 1024,
+			ErrCount: 0,
+		},
+		{
+			Value:    s3MultipartThreshold,
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, errors := validateS3MultipartThreshold(tc.Value, "multipart_threshold")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %d, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
 func testAccCheckS3BucketObjectSSE(n, expectedSSE string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, _ := s.RootModule().Resources[n]
@@ -526,6 +649,21 @@ resource "opentelekomcloud_s3_bucket_object" "object" {
 `, randInt, source, source)
 }
 
+func testAccS3BucketObjectConfig_multipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+}
+
+resource "opentelekomcloud_s3_bucket_object" "object" {
+	bucket = "${opentelekomcloud_s3_bucket.object_bucket.bucket}"
+	key = "multipart-key"
+	source = "%s"
+	multipart_threshold = 5242880
+}
+`, randInt, source)
+}
+
 func testAccS3BucketObjectConfig_updatesWithVersioning(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_s3_bucket" "object_bucket_3" {
@@ -572,3 +710,24 @@ resource "opentelekomcloud_s3_bucket_object" "object" {
 }
 `, randInt, acl)
 }
+
+func testAccS3BucketObjectConfig_storageClass(randInt int, storageClass, owner string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "opentelekomcloud_s3_bucket_object" "object" {
+	bucket = "${opentelekomcloud_s3_bucket.object_bucket.bucket}"
+	key = "archived-key"
+	content = "archived object"
+	storage_class = "%s"
+	metadata {
+		owner = "%s"
+	}
+}
+`, randInt, storageClass, owner)
+}
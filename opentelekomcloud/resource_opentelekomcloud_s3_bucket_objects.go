@@ -0,0 +1,503 @@
+package opentelekomcloud
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// resourceS3BucketObjects syncs the files of a local directory to the
+// objects under a prefix of a bucket. Its ID is the bucket and the prefix.
+func resourceS3BucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceS3BucketObjectsCreate,
+		Read:   resourceS3BucketObjectsRead,
+		Update: resourceS3BucketObjectsUpdate,
+		Delete: resourceS3BucketObjectsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateS3BucketObjectsPrefix,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"acl": {
+				Type:         schema.TypeString,
+				Default:      "private",
+				Optional:     true,
+				ValidateFunc: validateS3BucketObjectAclType,
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"delete_stale": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"parallelism": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value < 1 {
+						errors = append(errors, fmt.Errorf(
+							"Only numbers greater than 0 are supported values for 'parallelism'"))
+					}
+					return
+				},
+			},
+
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3MultipartThreshold,
+				ValidateFunc: validateS3MultipartThreshold,
+			},
+
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// s3LocalObject is a file of the source directory.
+type s3LocalObject struct {
+	path string
+	size int64
+	etag string
+}
+
+func resourceS3BucketObjectsCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string) + "/" + d.Get("prefix").(string))
+
+	if err := resourceS3BucketObjectsSync(d, meta, true); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceS3BucketObjectsRead(d, meta)
+}
+
+func resourceS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	remote, err := resourceS3BucketObjectsRemote(s3conn, bucket, prefix)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket) {
+			log.Printf("[WARN] S3 Bucket (%s) not found, removing objects %s from state", bucket, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing objects of S3 bucket (%s): %s", bucket, err)
+	}
+
+	uploaded := d.Get("etags").(map[string]interface{})
+
+	etags := make(map[string]string)
+	for key, v := range uploaded {
+		if object, ok := remote[key]; ok {
+			etags[key] = resourceS3BucketObjectsETag(object, v.(string))
+		}
+	}
+
+	// Without the source directory, e.g. when planning on a machine lacking
+	// it, the files can not be compared and the stored source directory is
+	// kept.
+	inSync := true
+	local, err := resourceS3BucketObjectsLocal(d.Get("source_dir").(string), prefix)
+	if err != nil {
+		log.Printf("[WARN] Unable to read the source directory of S3 bucket objects %s: %s", d.Id(), err)
+	}
+
+	for key, object := range local {
+		etag, _ := uploaded[key].(string)
+		if resourceS3BucketObjectsChanged(object, remote[key], etag) {
+			log.Printf("[DEBUG] S3 bucket object %s differs from %s", key, object.path)
+			inSync = false
+		}
+		if remoteObject, ok := remote[key]; ok {
+			etags[key] = resourceS3BucketObjectsETag(remoteObject, etag)
+		}
+	}
+
+	if d.Get("delete_stale").(bool) && local != nil {
+		for key := range remote {
+			if _, ok := local[key]; !ok {
+				log.Printf("[DEBUG] S3 bucket object %s is not in the source directory", key)
+				inSync = false
+			}
+		}
+	}
+
+	// The differences are shown as a change of the source directory, as
+	// computed attributes can not cause a diff.
+	if !inSync {
+		d.Set("source_dir", "")
+	}
+
+	if err := d.Set("etags", etags); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving etags to state for S3 bucket objects (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceS3BucketObjectsUpdate(d *schema.ResourceData, meta interface{}) error {
+	all := d.HasChange("acl") || d.HasChange("cache_control")
+	if err := resourceS3BucketObjectsSync(d, meta, all); err != nil {
+		return err
+	}
+
+	return resourceS3BucketObjectsRead(d, meta)
+}
+
+func resourceS3BucketObjectsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+	for key := range d.Get("etags").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	log.Printf("[DEBUG] Deleting %d objects of S3 bucket objects %s", len(keys), d.Id())
+	err = s3DeleteObjects(s3conn, bucket, keys)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting objects from S3 bucket (%s): %s", bucket, err)
+	}
+
+	return nil
+}
+
+// resourceS3BucketObjectsSync uploads the files of the source directory that
+// differ from their objects, or all files if all is set, and deletes the
+// stale objects. The uploaded files are saved as the etags.
+func resourceS3BucketObjectsSync(d *schema.ResourceData, meta interface{}, all bool) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	local, err := resourceS3BucketObjectsLocal(d.Get("source_dir").(string), prefix)
+	if err != nil {
+		return fmt.Errorf("Error reading the source directory of S3 bucket objects: %s", err)
+	}
+
+	remote, err := resourceS3BucketObjectsRemote(s3conn, bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("Error listing objects of S3 bucket (%s): %s", bucket, err)
+	}
+
+	uploaded := d.Get("etags").(map[string]interface{})
+
+	var uploads []string
+	for key, object := range local {
+		etag, _ := uploaded[key].(string)
+		if all || resourceS3BucketObjectsChanged(object, remote[key], etag) {
+			uploads = append(uploads, key)
+		}
+	}
+	sort.Strings(uploads)
+
+	input := s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		ACL:    aws.String(d.Get("acl").(string)),
+	}
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	threshold := int64(d.Get("multipart_threshold").(int))
+	parallelism := d.Get("parallelism").(int)
+
+	log.Printf("[DEBUG] Uploading %d of %d files to S3 bucket (%s)", len(uploads), len(local), bucket)
+	err = resourceS3BucketObjectsUpload(s3conn, input, local, uploads, threshold, parallelism)
+
+	// The etags are saved even if some uploads failed, as they are needed to
+	// compare the objects uploaded in parts. Files that might not have been
+	// uploaded keep their previous etag.
+	etags := make(map[string]string)
+	for key, object := range local {
+		etags[key] = object.etag
+	}
+	if err != nil {
+		for _, key := range uploads {
+			if etag, ok := uploaded[key].(string); ok {
+				etags[key] = etag
+			} else {
+				delete(etags, key)
+			}
+		}
+	}
+	d.Set("etags", etags)
+
+	if err != nil {
+		return err
+	}
+
+	if d.Get("delete_stale").(bool) {
+		var stale []string
+		for key := range remote {
+			if _, ok := local[key]; !ok {
+				stale = append(stale, key)
+			}
+		}
+		sort.Strings(stale)
+
+		log.Printf("[DEBUG] Deleting %d stale objects of S3 bucket (%s)", len(stale), bucket)
+		if err := s3DeleteObjects(s3conn, bucket, stale); err != nil {
+			return fmt.Errorf("Error deleting stale objects from S3 bucket (%s): %s", bucket, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceS3BucketObjectsUpload uploads the files keys of local with
+// parallelism uploads at a time.
+func resourceS3BucketObjectsUpload(s3conn *s3.S3, input s3manager.UploadInput, local map[string]*s3LocalObject, keys []string, threshold int64, parallelism int) error {
+	jobs := make(chan string)
+	errs := make(chan error, len(keys))
+
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range jobs {
+				if err := resourceS3BucketObjectsPut(s3conn, input, key, local[key], threshold); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+
+	for _, key := range keys {
+		jobs <- key
+	}
+	close(jobs)
+
+	wg.Wait()
+	close(errs)
+
+	var errors error
+	for err := range errs {
+		errors = multierror.Append(errors, err)
+	}
+
+	return errors
+}
+
+func resourceS3BucketObjectsPut(s3conn *s3.S3, input s3manager.UploadInput, key string, object *s3LocalObject, threshold int64) error {
+	file, err := os.Open(object.path)
+	if err != nil {
+		return fmt.Errorf("Error opening S3 bucket object source (%s): %s", object.path, err)
+	}
+	defer file.Close()
+
+	contentType, err := s3ContentType(file)
+	if err != nil {
+		return fmt.Errorf("Error reading S3 bucket object source (%s): %s", object.path, err)
+	}
+
+	input.Key = aws.String(key)
+	input.ContentType = aws.String(contentType)
+	input.Body = file
+
+	log.Printf("[DEBUG] Uploading %s to S3 bucket object %s", object.path, key)
+	if _, err := s3UploadObject(s3conn, &input, threshold); err != nil {
+		return fmt.Errorf("Error putting object %s in S3 bucket (%s): %s", key, *input.Bucket, err)
+	}
+
+	return nil
+}
+
+// s3ContentType returns the content type of a file from its extension, or
+// from its first bytes if the extension is unknown.
+func s3ContentType(file *os.File) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(file.Name())); contentType != "" {
+		return contentType, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	if _, err := file.Seek(0, 0); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// resourceS3BucketObjectsLocal returns the regular files of a directory by
+// the keys of their objects.
+func resourceS3BucketObjectsLocal(dir, prefix string) (map[string]*s3LocalObject, error) {
+	path, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir in source_dir (%s): %s", dir, err)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("source_dir is empty")
+	}
+
+	objects := make(map[string]*s3LocalObject)
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+
+		etag, err := s3FileMD5(p)
+		if err != nil {
+			return err
+		}
+
+		objects[resourceS3BucketObjectsKey(prefix, rel)] = &s3LocalObject{
+			path: p,
+			size: info.Size(),
+			etag: etag,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// resourceS3BucketObjectsKey returns the key of the object of the file at the
+// path rel relative to the source directory.
+func resourceS3BucketObjectsKey(prefix, rel string) string {
+	return prefix + filepath.ToSlash(rel)
+}
+
+func s3FileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// resourceS3BucketObjectsRemote returns the objects under a prefix of a
+// bucket by their keys.
+func resourceS3BucketObjectsRemote(s3conn *s3.S3, bucket, prefix string) (map[string]*s3.Object, error) {
+	objects := make(map[string]*s3.Object)
+
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	err := s3conn.ListObjectsPages(input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = object
+		}
+		return true
+	})
+
+	return objects, err
+}
+
+// resourceS3BucketObjectsETag returns the ETag of an object, or the MD5 it
+// was uploaded with if it was uploaded in parts.
+func resourceS3BucketObjectsETag(object *s3.Object, uploaded string) string {
+	etag := strings.Trim(aws.StringValue(object.ETag), `"`)
+	if s3IsMultipartETag(etag) && uploaded != "" {
+		return uploaded
+	}
+	return etag
+}
+
+// resourceS3BucketObjectsChanged reports whether a file differs from its
+// object. Objects uploaded in parts are compared by their size and the MD5
+// they were uploaded with, as their ETag is not their MD5.
+func resourceS3BucketObjectsChanged(local *s3LocalObject, remote *s3.Object, uploaded string) bool {
+	if remote == nil {
+		return true
+	}
+
+	etag := strings.Trim(aws.StringValue(remote.ETag), `"`)
+	if s3IsMultipartETag(etag) {
+		return aws.Int64Value(remote.Size) != local.size || uploaded != local.etag
+	}
+
+	return etag != local.etag
+}
@@ -0,0 +1,241 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestAccS3BucketObjects_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-acc-s3-objs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"index.html":   "<html><body>index</body></html>",
+		"css/site.css": "body { color: black; }",
+		"robots.txt":   "User-agent: *",
+	}
+	for name, content := range files {
+		testAccWriteS3BucketObjectsFile(t, dir, name, content)
+	}
+
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketObjectsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccS3BucketObjectsConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketObjectsExists("opentelekomcloud_s3_bucket_objects.site", 3),
+					testAccCheckS3BucketObjectContentType(rInt, "site/index.html", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket_objects.site", "etags.%", "3"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket_objects.site", "etags.site/robots.txt", "ca121b5d03245bf82db00d14cee04e22"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: func() {
+					testAccWriteS3BucketObjectsFile(t, dir, "robots.txt", "User-agent: *\nDisallow: /")
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccS3BucketObjectsConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketObjectsExists("opentelekomcloud_s3_bucket_objects.site", 2),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket_objects.site", "etags.%", "2"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket_objects.site", "etags.site/robots.txt", "9152d7f1724ed8fbcd2e0c87029f193c"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceS3BucketObjectsLocal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-s3-objs-local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testAccWriteS3BucketObjectsFile(t, dir, "index.html", "index")
+	testAccWriteS3BucketObjectsFile(t, dir, "css/site.css", "css")
+
+	objects, err := resourceS3BucketObjectsLocal(dir, "site/")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"site/index.html":   filepath.Join(dir, "index.html"),
+		"site/css/site.css": filepath.Join(dir, "css", "site.css"),
+	}
+	if len(objects) != len(expected) {
+		t.Fatalf("Expected %d objects, got %#v", len(expected), objects)
+	}
+	for key, path := range expected {
+		object, ok := objects[key]
+		if !ok {
+			t.Fatalf("Expected object %s, got %#v", key, objects)
+		}
+		if object.path != path {
+			t.Fatalf("Bad path of object %s: %s", key, object.path)
+		}
+	}
+}
+
+func TestResourceS3BucketObjectsPrefix_validation(t *testing.T) {
+	var testCases = []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "site/",
+			ErrCount: 0,
+		},
+		{
+			Value:    "public/site/",
+			ErrCount: 0,
+		},
+		{
+			Value:    "site",
+			ErrCount: 1,
+		},
+		{
+			Value:    "/",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, errors := validateS3BucketObjectsPrefix(tc.Value, "prefix")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func testAccWriteS3BucketObjectsFile(t *testing.T, dir, name, content string) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckS3BucketObjectsDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	s3conn, err := config.computeS3conn(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_s3_bucket_objects" {
+			continue
+		}
+
+		objects, err := resourceS3BucketObjectsRemote(
+			s3conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["prefix"])
+		if err == nil && len(objects) > 0 {
+			return fmt.Errorf("S3 bucket objects still exist: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckS3BucketObjectsExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Objects ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		s3conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+		}
+
+		objects, err := resourceS3BucketObjectsRemote(
+			s3conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["prefix"])
+		if err != nil {
+			return fmt.Errorf("S3Bucket Objects error: %s", err)
+		}
+
+		if len(objects) != count {
+			return fmt.Errorf("Expected %d objects, got %d", count, len(objects))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckS3BucketObjectContentType(randInt int, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		s3conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+		}
+
+		out, err := s3conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(fmt.Sprintf("tf-objects-test-bucket-%d", randInt)),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("S3Bucket Object error: %s", err)
+		}
+
+		if v := aws.StringValue(out.ContentType); v != contentType {
+			return fmt.Errorf("Bad content type of %s: %s", key, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccS3BucketObjectsConfig(randInt int, dir string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "objects_bucket" {
+	bucket = "tf-objects-test-bucket-%d"
+}
+
+resource "opentelekomcloud_s3_bucket_objects" "site" {
+	bucket = "${opentelekomcloud_s3_bucket.objects_bucket.bucket}"
+	prefix = "site/"
+	source_dir = "%s"
+	cache_control = "max-age=300"
+	delete_stale = true
+	parallelism = 2
+}
+`, randInt, dir)
+}
@@ -569,6 +569,161 @@ func TestAccS3Bucket_Lifecycle(t *testing.T) {
 	})
 }
 
+func TestAccS3Bucket_LifecycleTransition(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketConfigWithLifecycleTransition(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "lifecycle_rule.0.id", "id1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "lifecycle_rule.0.transition.#", "2"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "lifecycle_rule.0.expiration.#", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "lifecycle_rule.1.id", "id2"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "lifecycle_rule.1.noncurrent_version_transition.#", "1"),
+					testAccCheckS3BucketLifecycleTransition(
+						"opentelekomcloud_s3_bucket.bucket", s3.TransitionStorageClassStandardIa, 30),
+					testAccCheckS3BucketLifecycleTransition(
+						"opentelekomcloud_s3_bucket.bucket", s3.TransitionStorageClassGlacier, 60),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Encryption(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketConfigWithEncryption(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket",
+						"server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "aws:kms"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_s3_bucket.bucket",
+						"server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.kms_master_key_id",
+						"opentelekomcloud_kms_key_v1.key_1", "id"),
+				),
+			},
+			{
+				Config: testAccS3BucketConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "server_side_encryption_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Replication(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckS3Replication(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketConfigWithReplication(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "replication_configuration.0.role", OS_OBS_REPLICATION_AGENCY),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Notification(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketConfigWithNotification(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "notification.#", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "notification.0.id", "notification1"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_s3_bucket.bucket", "notification.0.topic_urn",
+						"opentelekomcloud_smn_topic_v2.topic_1", "topic_urn"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "notification.0.filter_prefix", "images/"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "notification.0.filter_suffix", ".jpg"),
+				),
+			},
+			{
+				Config: testAccS3BucketConfigWithNotificationTopic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "notification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_StorageClassAndQuota(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketConfigWithStorageClassAndQuota(rInt, "WARM", 1073741824),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "storage_class", "WARM"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "quota", "1073741824"),
+				),
+			},
+			{
+				Config: testAccS3BucketConfigWithStorageClassAndQuota(rInt, "COLD", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "storage_class", "COLD"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "quota", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestS3BucketName(t *testing.T) {
 	validDnsNames := []string{
 		"foobar",
@@ -944,6 +1099,34 @@ func testAccCheckS3BucketLogging(n, b, p string) resource.TestCheckFunc {
 
 // These need a bit of randomness as the name can only be used once globally
 // within AWS
+func testAccCheckS3BucketLifecycleTransition(n string, storageClass string, days int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, _ := s.RootModule().Resources[n]
+		config := testAccProvider.Meta().(*Config)
+		conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+		}
+
+		out, err := conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("GetBucketLifecycleConfiguration error: %v", err)
+		}
+
+		for _, rule := range out.Rules {
+			for _, transition := range rule.Transitions {
+				if aws.StringValue(transition.StorageClass) == storageClass && aws.Int64Value(transition.Days) == days {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("No transition to %s after %d days found", storageClass, days)
+	}
+}
+
 func testAccBucketName(randInt int) string {
 	return fmt.Sprintf("tf-test-bucket-%d", randInt)
 }
@@ -1314,6 +1497,127 @@ resource "opentelekomcloud_s3_bucket" "bucket" {
 `, randInt)
 }
 
+func testAccS3BucketConfigWithLifecycleTransition(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	versioning {
+	  enabled = true
+	}
+	lifecycle_rule {
+		id = "id1"
+		prefix = "path1/"
+		enabled = true
+
+		transition {
+			days = 30
+			storage_class = "WARM"
+		}
+		transition {
+			days = 60
+			storage_class = "COLD"
+		}
+		expiration {
+			days = 365
+		}
+	}
+	lifecycle_rule {
+		id = "id2"
+		prefix = "path2/"
+		enabled = true
+
+		noncurrent_version_transition {
+			days = 30
+			storage_class = "WARM"
+		}
+	}
+}
+`, randInt)
+}
+
+func testAccS3BucketConfigWithEncryption(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+	key_alias = "tf-test-key-%d"
+	pending_days = "7"
+}
+
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	server_side_encryption_configuration {
+		rule {
+			apply_server_side_encryption_by_default {
+				kms_master_key_id = "${opentelekomcloud_kms_key_v1.key_1.id}"
+				sse_algorithm = "aws:kms"
+			}
+		}
+	}
+}
+`, randInt, randInt)
+}
+
+func testAccS3BucketConfigWithReplication(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	versioning {
+		enabled = true
+	}
+	replication_configuration {
+		role = "%s"
+		rules {
+			id = "foobar"
+			prefix = "foo"
+			status = "Enabled"
+
+			destination {
+				bucket = "%s"
+				storage_class = "WARM"
+			}
+		}
+	}
+}
+`, randInt, OS_OBS_REPLICATION_AGENCY, OS_OBS_REPLICATION_BUCKET)
+}
+
+func testAccS3BucketConfigWithNotificationTopic(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+	name = "tf-test-topic-%d"
+	display_name = "Notifications of tf-test-bucket-%d"
+}
+
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+}
+`, randInt, randInt, randInt)
+}
+
+func testAccS3BucketConfigWithNotification(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+	name = "tf-test-topic-%d"
+	display_name = "Notifications of tf-test-bucket-%d"
+}
+
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	notification {
+		id = "notification1"
+		topic_urn = "${opentelekomcloud_smn_topic_v2.topic_1.topic_urn}"
+		events = ["s3:ObjectCreated:*"]
+		filter_prefix = "images/"
+		filter_suffix = ".jpg"
+	}
+}
+`, randInt, randInt, randInt)
+}
+
 const testAccS3BucketConfig_namePrefix = `
 resource "opentelekomcloud_s3_bucket" "test" {
 	bucket_prefix = "tf-test-"
@@ -1325,3 +1629,14 @@ resource "opentelekomcloud_s3_bucket" "test" {
 	bucket_prefix = "tf-test-"
 }
 `
+
+func testAccS3BucketConfigWithStorageClassAndQuota(randInt int, storageClass string, quota int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	storage_class = "%s"
+	quota = %d
+}
+`, randInt, storageClass, quota)
+}
@@ -20,6 +20,67 @@ func resourceSubnetDNSListV1(d *schema.ResourceData) []string {
 	return dnsn
 }
 
+// VpcSubnetV1Extension holds the subnet attributes which are not part of
+// subnets.Subnet.
+type VpcSubnetV1Extension struct {
+	IPv6Enable    bool                    `json:"ipv6_enable"`
+	CidrV6        string                  `json:"cidr_v6"`
+	GatewayIPV6   string                  `json:"gateway_ip_v6"`
+	IPv6SubnetID  string                  `json:"ipv6_subnet_id"`
+	ExtraDhcpOpts []VpcSubnetExtraDhcpOpt `json:"extra_dhcp_opts"`
+}
+
+// The names of the extra DHCP options and the attributes they are mapped to.
+var vpcSubnetV1DhcpOpts = map[string]string{
+	"ntp":         "ntp_addresses",
+	"domainname":  "dhcp_domain_name",
+	"addresstime": "dhcp_lease_time",
+}
+
+func resourceSubnetExtraDhcpOptsV1(d *schema.ResourceData) []VpcSubnetExtraDhcpOpt {
+	var opts []VpcSubnetExtraDhcpOpt
+	for _, name := range []string{"ntp", "domainname", "addresstime"} {
+		attr := vpcSubnetV1DhcpOpts[name]
+		value := d.Get(attr).(string)
+		if value == "" {
+			// Only send an explicit removal for options which were set before
+			if old, _ := d.GetChange(attr); old.(string) == "" {
+				continue
+			}
+			opts = append(opts, VpcSubnetExtraDhcpOpt{OptName: name})
+			continue
+		}
+		opts = append(opts, VpcSubnetExtraDhcpOpt{OptName: name, OptValue: &value})
+	}
+	return opts
+}
+
+func getVpcSubnetV1Extension(r subnets.GetResult) (*VpcSubnetV1Extension, error) {
+	var s struct {
+		Subnet VpcSubnetV1Extension `json:"subnet"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return &s.Subnet, nil
+}
+
+func setVpcSubnetV1Extension(d *schema.ResourceData, ext *VpcSubnetV1Extension) {
+	d.Set("ipv6_enable", ext.IPv6Enable)
+	d.Set("cidr_v6", ext.CidrV6)
+	d.Set("gateway_ip_v6", ext.GatewayIPV6)
+	d.Set("ipv6_subnet_id", ext.IPv6SubnetID)
+	for name, attr := range vpcSubnetV1DhcpOpts {
+		d.Set(attr, "")
+		for _, opt := range ext.ExtraDhcpOpts {
+			if opt.OptName == name && opt.OptValue != nil {
+				d.Set(attr, *opt.OptValue)
+			}
+		}
+	}
+}
+
 func resourceVpcSubnetV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVpcSubnetV1Create,
@@ -98,6 +159,37 @@ func resourceVpcSubnetV1() *schema.Resource {
 				ForceNew: true,
 				Required: true,
 			},
+			"ipv6_enable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cidr_v6": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_ip_v6": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ntp_addresses": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dhcp_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dhcp_lease_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -110,16 +202,20 @@ func resourceVpcSubnetV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	createOpts := subnets.CreateOpts{
-		Name:             d.Get("name").(string),
-		CIDR:             d.Get("cidr").(string),
-		AvailabilityZone: d.Get("availability_zone").(string),
-		GatewayIP:        d.Get("gateway_ip").(string),
-		EnableDHCP:       d.Get("dhcp_enable").(bool),
-		VPC_ID:           d.Get("vpc_id").(string),
-		PRIMARY_DNS:      d.Get("primary_dns").(string),
-		SECONDARY_DNS:    d.Get("secondary_dns").(string),
-		DnsList:          resourceSubnetDNSListV1(d),
+	createOpts := VpcSubnetCreateOpts{
+		subnets.CreateOpts{
+			Name:             d.Get("name").(string),
+			CIDR:             d.Get("cidr").(string),
+			AvailabilityZone: d.Get("availability_zone").(string),
+			GatewayIP:        d.Get("gateway_ip").(string),
+			EnableDHCP:       d.Get("dhcp_enable").(bool),
+			VPC_ID:           d.Get("vpc_id").(string),
+			PRIMARY_DNS:      d.Get("primary_dns").(string),
+			SECONDARY_DNS:    d.Get("secondary_dns").(string),
+			DnsList:          resourceSubnetDNSListV1(d),
+		},
+		d.Get("ipv6_enable").(bool),
+		resourceSubnetExtraDhcpOptsV1(d),
 	}
 
 	n, err := subnets.Create(subnetClient, createOpts).Extract()
@@ -146,6 +242,16 @@ func resourceVpcSubnetV1Create(d *schema.ResourceData, meta interface{}) error {
 			n.ID, stateErr)
 	}
 
+	if _, ok := d.GetOk("tags"); ok {
+		tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+		if err := resourceVpcTagsV2Update(tagClient, d, "subnets", n.ID); err != nil {
+			return err
+		}
+	}
+
 	return resourceVpcSubnetV1Read(d, config)
 
 }
@@ -157,7 +263,8 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	n, err := subnets.Get(subnetClient, d.Id()).Extract()
+	r := subnets.Get(subnetClient, d.Id())
+	n, err := r.Extract()
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
@@ -167,6 +274,11 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error retrieving OpenTelekomCloud Subnets: %s", err)
 	}
 
+	ext, err := getVpcSubnetV1Extension(r)
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenTelekomCloud Subnets: %s", err)
+	}
+
 	d.Set("name", n.Name)
 	d.Set("cidr", n.CIDR)
 	d.Set("dns_list", n.DnsList)
@@ -176,8 +288,19 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("secondary_dns", n.SECONDARY_DNS)
 	d.Set("availability_zone", n.AvailabilityZone)
 	d.Set("vpc_id", n.VPC_ID)
+	setVpcSubnetV1Extension(d, ext)
 	d.Set("region", GetRegion(d, config))
 
+	tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+	tags, err := resourceVpcTagsV2Get(tagClient, "subnets", d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving tags of OpenTelekomCloud Subnet %s: %s", d.Id(), err)
+	}
+	d.Set("tags", tags)
+
 	return nil
 }
 
@@ -188,7 +311,7 @@ func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	var updateOpts subnets.UpdateOpts
+	var updateOpts VpcSubnetUpdateOpts
 
 	//as name is mandatory while updating subnet
 	updateOpts.Name = d.Get("name").(string)
@@ -209,6 +332,16 @@ func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
 		updateOpts.EnableDHCP = true
 	}
 
+	if d.HasChange("ipv6_enable") {
+		if !d.Get("ipv6_enable").(bool) {
+			return fmt.Errorf("IPv6 can not be disabled on OpenTelekomCloud VPC Subnet %s", d.Id())
+		}
+		updateOpts.IPv6Enable = true
+	}
+	if d.HasChange("ntp_addresses") || d.HasChange("dhcp_domain_name") || d.HasChange("dhcp_lease_time") {
+		updateOpts.ExtraDhcpOpts = resourceSubnetExtraDhcpOptsV1(d)
+	}
+
 	vpc_id := d.Get("vpc_id").(string)
 
 	_, err = subnets.Update(subnetClient, vpc_id, d.Id(), updateOpts).Extract()
@@ -216,6 +349,14 @@ func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error updating OpenTelekomCloud VPC Subnet: %s", err)
 	}
 
+	tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+	if err := resourceVpcTagsV2Update(tagClient, d, "subnets", d.Id()); err != nil {
+		return err
+	}
+
 	return resourceVpcSubnetV1Read(d, meta)
 }
 
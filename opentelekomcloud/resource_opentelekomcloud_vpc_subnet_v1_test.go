@@ -43,6 +43,54 @@ func TestAccOTCVpcSubnetV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcSubnetV1_ipv6DhcpTags(t *testing.T) {
+	var subnet subnets.Subnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCVpcSubnetV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOTCVpcSubnetV1_ipv6DhcpTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcSubnetV1Exists("opentelekomcloud_vpc_subnet_v1.subnet_1", &subnet),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "ipv6_enable", "false"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "ntp_addresses", "10.100.0.33"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "dhcp_domain_name", "example.com"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "tags.foo", "bar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOTCVpcSubnetV1_ipv6DhcpTagsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcSubnetV1Exists("opentelekomcloud_vpc_subnet_v1.subnet_1", &subnet),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "ipv6_enable", "true"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "cidr_v6"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "gateway_ip_v6"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "ntp_addresses", "10.100.0.33,10.100.0.34"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "dhcp_domain_name", ""),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "dhcp_lease_time", "48h"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_subnet_v1.subnet_1", "tags.key", "value"),
+				),
+			},
+		},
+	})
+}
+
 // PASS
 func TestAccOTCVpcSubnetV1_timeout(t *testing.T) {
 	var subnet subnets.Subnet
@@ -164,3 +212,46 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
 
 }
 `
+
+const testAccOTCVpcSubnetV1_ipv6DhcpTags = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name = "opentelekomcloud_subnet"
+  cidr = "192.168.0.0/16"
+  gateway_ip = "192.168.0.1"
+  vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  availability_zone = "eu-de-02"
+  ntp_addresses = "10.100.0.33"
+  dhcp_domain_name = "example.com"
+
+  tags {
+    foo = "bar"
+  }
+}
+`
+
+const testAccOTCVpcSubnetV1_ipv6DhcpTagsUpdate = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name = "opentelekomcloud_subnet"
+  cidr = "192.168.0.0/16"
+  gateway_ip = "192.168.0.1"
+  vpc_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  availability_zone = "eu-de-02"
+  ipv6_enable = true
+  ntp_addresses = "10.100.0.33,10.100.0.34"
+  dhcp_lease_time = "48h"
+
+  tags {
+    key = "value"
+  }
+}
+`
@@ -53,6 +53,16 @@ func resourceVirtualPrivateCloudV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"secondary_cidrs": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDR,
+				},
+				Set: schema.HashString,
+			},
+			"tags": tagsSchema(),
 		},
 	}
 }
@@ -95,6 +105,27 @@ func resourceVirtualPrivateCloudV1Create(d *schema.ResourceData, meta interface{
 			n.ID, stateErr)
 	}
 
+	if v, ok := d.GetOk("secondary_cidrs"); ok {
+		v3Client, err := config.networkingV3Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud vpc v3 client: %s", err)
+		}
+		err = vpcV1ExtendCidrsAction(v3Client, n.ID, "add-extend-cidr", v.(*schema.Set).List())
+		if err != nil {
+			return fmt.Errorf("Error adding secondary CIDRs to OpenTelekomCloud VPC %s: %s", n.ID, err)
+		}
+	}
+
+	if _, ok := d.GetOk("tags"); ok {
+		tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+		if err := resourceVpcTagsV2Update(tagClient, d, "vpcs", n.ID); err != nil {
+			return err
+		}
+	}
+
 	return resourceVirtualPrivateCloudV1Read(d, meta)
 
 }
@@ -123,6 +154,30 @@ func resourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{})
 	d.Set("shared", n.EnableSharedSnat)
 	d.Set("region", GetRegion(d, config))
 
+	// The v3 and the tag API are not available in every region, so a missing
+	// endpoint or VPC there is read as no secondary CIDRs and no tags.
+	if _, ok := d.GetOk("secondary_cidrs"); ok {
+		var secondaryCidrs []string
+		v3Client, err := config.networkingV3Client(GetRegion(d, config))
+		if err == nil {
+			secondaryCidrs, err = vpcV1GetExtendCidrs(v3Client, d.Id())
+		}
+		if err != nil && !isEndpointOrResourceNotFound(err) {
+			return fmt.Errorf("Error retrieving secondary CIDRs of OpenTelekomCloud Vpc %s: %s", d.Id(), err)
+		}
+		d.Set("secondary_cidrs", secondaryCidrs)
+	}
+
+	var tags map[string]string
+	tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err == nil {
+		tags, err = resourceVpcTagsV2Get(tagClient, "vpcs", d.Id())
+	}
+	if err != nil && !isEndpointOrResourceNotFound(err) {
+		return fmt.Errorf("Error retrieving tags of OpenTelekomCloud Vpc %s: %s", d.Id(), err)
+	}
+	d.Set("tags", tags)
+
 	return nil
 }
 
@@ -133,18 +188,53 @@ func resourceVirtualPrivateCloudV1Update(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating OpenTelekomCloud Vpc: %s", err)
 	}
 
-	var updateOpts vpcs.UpdateOpts
+	if d.HasChange("name") || d.HasChange("cidr") {
+		var updateOpts vpcs.UpdateOpts
+
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("cidr") {
+			updateOpts.CIDR = d.Get("cidr").(string)
+		}
 
-	if d.HasChange("name") {
-		updateOpts.Name = d.Get("name").(string)
+		_, err = vpcs.Update(vpcClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud Vpc: %s", err)
+		}
 	}
-	if d.HasChange("cidr") {
-		updateOpts.CIDR = d.Get("cidr").(string)
+
+	if d.HasChange("secondary_cidrs") {
+		v3Client, err := config.networkingV3Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud vpc v3 client: %s", err)
+		}
+
+		o, n := d.GetChange("secondary_cidrs")
+		oldCidrs := o.(*schema.Set)
+		newCidrs := n.(*schema.Set)
+
+		// Remove first, so a CIDR can be replaced by an overlapping one
+		if remove := oldCidrs.Difference(newCidrs).List(); len(remove) > 0 {
+			if err := vpcV1ExtendCidrsAction(v3Client, d.Id(), "remove-extend-cidr", remove); err != nil {
+				return fmt.Errorf("Error removing secondary CIDRs from OpenTelekomCloud Vpc %s: %s", d.Id(), err)
+			}
+		}
+		if add := newCidrs.Difference(oldCidrs).List(); len(add) > 0 {
+			if err := vpcV1ExtendCidrsAction(v3Client, d.Id(), "add-extend-cidr", add); err != nil {
+				return fmt.Errorf("Error adding secondary CIDRs to OpenTelekomCloud Vpc %s: %s", d.Id(), err)
+			}
+		}
 	}
 
-	_, err = vpcs.Update(vpcClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating OpenTelekomCloud Vpc: %s", err)
+	if d.HasChange("tags") {
+		tagClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+		if err := resourceVpcTagsV2Update(tagClient, d, "vpcs", d.Id()); err != nil {
+			return err
+		}
 	}
 
 	return resourceVirtualPrivateCloudV1Read(d, meta)
@@ -226,3 +316,36 @@ func waitForVpcDelete(vpcClient *golangsdk.ServiceClient, vpcId string) resource
 		return r, "ACTIVE", nil
 	}
 }
+
+func vpcV3URL(c *golangsdk.ServiceClient, parts ...string) string {
+	return c.ServiceURL(append([]string{c.ProjectID, "vpc", "vpcs"}, parts...)...)
+}
+
+// vpcV1GetExtendCidrs returns the secondary CIDR blocks of a VPC. The client
+// is the one returned by networkingV3Client.
+func vpcV1GetExtendCidrs(c *golangsdk.ServiceClient, vpcID string) ([]string, error) {
+	var r struct {
+		Vpc struct {
+			ExtendCidrs []string `json:"extend_cidrs"`
+		} `json:"vpc"`
+	}
+	_, err := c.Get(vpcV3URL(c, vpcID), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.Vpc.ExtendCidrs, nil
+}
+
+// vpcV1ExtendCidrsAction adds or removes secondary CIDR blocks of a VPC,
+// action is either "add-extend-cidr" or "remove-extend-cidr".
+func vpcV1ExtendCidrsAction(c *golangsdk.ServiceClient, vpcID, action string, cidrs []interface{}) error {
+	b := map[string]interface{}{
+		"vpc": map[string]interface{}{
+			"extend_cidrs": cidrs,
+		},
+	}
+	_, err := c.Put(vpcV3URL(c, vpcID, action), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
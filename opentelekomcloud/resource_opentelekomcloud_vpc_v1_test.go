@@ -65,6 +65,42 @@ func TestAccOTCVpcV1_update(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcV1_secondaryCidrsTags(t *testing.T) {
+	var vpc vpcs.Vpc
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCVpcV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcV1_secondaryCidrsTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists("opentelekomcloud_vpc_v1.vpc_1", &vpc),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "secondary_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "tags.foo", "bar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcV1_secondaryCidrsTagsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists("opentelekomcloud_vpc_v1.vpc_1", &vpc),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "cidr", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "secondary_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "tags.key", "value"),
+				),
+			},
+		},
+	})
+}
+
 // PASS
 func TestAccOTCVpcV1_timeout(t *testing.T) {
 	var vpc vpcs.Vpc
@@ -161,3 +197,27 @@ resource "opentelekomcloud_vpc_v1" "vpc_1" {
   }
 }
 `
+
+const testAccVpcV1_secondaryCidrsTags = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+	name = "terraform_provider_test"
+	cidr="192.168.0.0/16"
+	secondary_cidrs = ["172.16.0.0/16"]
+
+  tags {
+    foo = "bar"
+  }
+}
+`
+
+const testAccVpcV1_secondaryCidrsTagsUpdate = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+	name = "terraform_provider_test"
+	cidr="192.168.0.0/16"
+	secondary_cidrs = ["172.16.0.0/16", "172.17.0.0/16"]
+
+  tags {
+    key = "value"
+  }
+}
+`
@@ -0,0 +1,218 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/endpointgroups"
+)
+
+func resourceVpnEndpointGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnEndpointGroupV2Create,
+		Read:   resourceVpnEndpointGroupV2Read,
+		Update: resourceVpnEndpointGroupV2Update,
+		Delete: resourceVpnEndpointGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"subnet", "cidr"})
+				},
+			},
+			"endpoints": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVpnEndpointGroupV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	rawEndpoints := d.Get("endpoints").([]interface{})
+	endpoints := make([]string, len(rawEndpoints))
+	for i, raw := range rawEndpoints {
+		endpoints[i] = raw.(string)
+	}
+
+	createOpts := VpnEndpointGroupCreateOpts{
+		endpointgroups.CreateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			TenantID:    d.Get("tenant_id").(string),
+			Type:        endpointgroups.EndpointType(d.Get("type").(string)),
+			Endpoints:   endpoints,
+		},
+		MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create VPN endpoint group: %#v", createOpts)
+
+	group, err := endpointgroups.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud VPN endpoint group: %s", err)
+	}
+
+	log.Printf("[DEBUG] VPN endpoint group %s created: %#v", group.ID, group)
+
+	d.SetId(group.ID)
+
+	return resourceVpnEndpointGroupV2Read(d, meta)
+}
+
+func resourceVpnEndpointGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Retrieve information about VPN endpoint group: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	group, err := endpointgroups.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN endpoint group")
+	}
+
+	log.Printf("[DEBUG] Read OpenTelekomCloud VPN endpoint group %s: %#v", d.Id(), group)
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	d.Set("tenant_id", group.TenantID)
+	d.Set("type", group.Type)
+	d.Set("endpoints", group.Endpoints)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpnEndpointGroupV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts endpointgroups.UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+		hasChange = true
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+		hasChange = true
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating VPN endpoint group %s with options: %#v", d.Id(), updateOpts)
+
+		_, err = endpointgroups.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud VPN endpoint group: %s", err)
+		}
+	}
+
+	return resourceVpnEndpointGroupV2Read(d, meta)
+}
+
+func resourceVpnEndpointGroupV2Delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Destroy VPN endpoint group: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	err = endpointgroups.Delete(networkingClient, d.Id()).Err
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenTelekomCloud VPN endpoint group")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpnEndpointGroupDeletion(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for VPN endpoint group (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForVpnEndpointGroupDeletion(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		group, err := endpointgroups.Get(networkingClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud VPN endpoint group %s", id)
+				return group, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		return group, "DELETING", nil
+	}
+}
@@ -0,0 +1,110 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/endpointgroups"
+)
+
+func TestAccVpnEndpointGroupV2_basic(t *testing.T) {
+	var group endpointgroups.EndpointGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnEndpointGroupV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnEndpointGroupV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnEndpointGroupV2Exists("opentelekomcloud_vpnaas_endpoint_group_v2.group_1", &group),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_endpoint_group_v2.group_1", "type", "cidr"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_endpoint_group_v2.group_1", "endpoints.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpnEndpointGroupV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnEndpointGroupV2Exists("opentelekomcloud_vpnaas_endpoint_group_v2.group_1", &group),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_endpoint_group_v2.group_1", "name", "group_updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpnEndpointGroupV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpnaas_endpoint_group_v2" {
+			continue
+		}
+
+		_, err = endpointgroups.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("VPN endpoint group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpnEndpointGroupV2Exists(n string, v *endpointgroups.EndpointGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := endpointgroups.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("VPN endpoint group not found")
+		}
+
+		*v = *found
+
+		return nil
+	}
+}
+
+const testAccVpnEndpointGroupV2_basic = `
+resource "opentelekomcloud_vpnaas_endpoint_group_v2" "group_1" {
+  name      = "group_1"
+  type      = "cidr"
+  endpoints = ["10.2.0.0/24", "10.3.0.0/24"]
+}
+`
+
+const testAccVpnEndpointGroupV2_update = `
+resource "opentelekomcloud_vpnaas_endpoint_group_v2" "group_1" {
+  name      = "group_updated"
+  type      = "cidr"
+  endpoints = ["10.2.0.0/24", "10.3.0.0/24"]
+}
+`
@@ -0,0 +1,320 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
+)
+
+func resourceVpnIKEPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnIKEPolicyV2Create,
+		Read:   resourceVpnIKEPolicyV2Read,
+		Update: resourceVpnIKEPolicyV2Update,
+		Delete: resourceVpnIKEPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auth_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sha1",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"md5", "sha1", "sha2-256", "sha2-384", "sha2-512"})
+				},
+			},
+			"encryption_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aes-128",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"3des", "aes-128", "aes-192", "aes-256"})
+				},
+			},
+			"pfs": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "group5",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"group1", "group2", "group5", "group14"})
+				},
+			},
+			"phase1_negotiation_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "main",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"main", "aggressive"})
+				},
+			},
+			"ike_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "v1",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"v1", "v2"})
+				},
+			},
+			"lifetime": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"units": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVpnIKEPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	createOpts := VpnIKEPolicyCreateOpts{
+		ikepolicies.CreateOpts{
+			Name:                  d.Get("name").(string),
+			Description:           d.Get("description").(string),
+			TenantID:              d.Get("tenant_id").(string),
+			AuthAlgorithm:         ikepolicies.AuthAlgorithm(d.Get("auth_algorithm").(string)),
+			EncryptionAlgorithm:   ikepolicies.EncryptionAlgorithm(d.Get("encryption_algorithm").(string)),
+			PFS:                   ikepolicies.PFS(d.Get("pfs").(string)),
+			Phase1NegotiationMode: ikepolicies.Phase1NegotiationMode(d.Get("phase1_negotiation_mode").(string)),
+			IKEVersion:            ikepolicies.IKEVersion(d.Get("ike_version").(string)),
+			Lifetime:              resourceVpnIKEPolicyV2LifetimeCreateOpts(d.Get("lifetime").(*schema.Set)),
+		},
+		MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create IKE policy: %#v", createOpts)
+
+	policy, err := ikepolicies.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud IKE policy: %s", err)
+	}
+
+	log.Printf("[DEBUG] IKE policy %s created: %#v", policy.ID, policy)
+
+	d.SetId(policy.ID)
+
+	return resourceVpnIKEPolicyV2Read(d, meta)
+}
+
+func resourceVpnIKEPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Retrieve information about IKE policy: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	policy, err := ikepolicies.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "IKE policy")
+	}
+
+	log.Printf("[DEBUG] Read OpenTelekomCloud IKE policy %s: %#v", d.Id(), policy)
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("auth_algorithm", policy.AuthAlgorithm)
+	d.Set("encryption_algorithm", policy.EncryptionAlgorithm)
+	d.Set("tenant_id", policy.TenantID)
+	d.Set("pfs", policy.PFS)
+	d.Set("phase1_negotiation_mode", policy.Phase1NegotiationMode)
+	d.Set("ike_version", policy.IKEVersion)
+	d.Set("region", GetRegion(d, config))
+
+	lifetime := []map[string]interface{}{
+		{
+			"units": policy.Lifetime.Units,
+			"value": policy.Lifetime.Value,
+		},
+	}
+	if err := d.Set("lifetime", lifetime); err != nil {
+		log.Printf("[WARN] unable to set IKE policy lifetime: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVpnIKEPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts ikepolicies.UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+		hasChange = true
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+		hasChange = true
+	}
+
+	if d.HasChange("auth_algorithm") {
+		updateOpts.AuthAlgorithm = ikepolicies.AuthAlgorithm(d.Get("auth_algorithm").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("encryption_algorithm") {
+		updateOpts.EncryptionAlgorithm = ikepolicies.EncryptionAlgorithm(d.Get("encryption_algorithm").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("pfs") {
+		updateOpts.PFS = ikepolicies.PFS(d.Get("pfs").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("phase1_negotiation_mode") {
+		updateOpts.Phase1NegotiationMode = ikepolicies.Phase1NegotiationMode(d.Get("phase1_negotiation_mode").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("ike_version") {
+		updateOpts.IKEVersion = ikepolicies.IKEVersion(d.Get("ike_version").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("lifetime") {
+		lifetime := resourceVpnIKEPolicyV2LifetimeCreateOpts(d.Get("lifetime").(*schema.Set))
+		if lifetime != nil {
+			updateOpts.Lifetime = &ikepolicies.LifetimeUpdateOpts{
+				Units: lifetime.Units,
+				Value: lifetime.Value,
+			}
+			hasChange = true
+		}
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating IKE policy %s with options: %#v", d.Id(), updateOpts)
+
+		_, err = ikepolicies.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud IKE policy: %s", err)
+		}
+	}
+
+	return resourceVpnIKEPolicyV2Read(d, meta)
+}
+
+func resourceVpnIKEPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Destroy IKE policy: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	err = ikepolicies.Delete(networkingClient, d.Id()).Err
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenTelekomCloud IKE policy")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpnIKEPolicyDeletion(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for IKE policy (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceVpnIKEPolicyV2LifetimeCreateOpts(lifetime *schema.Set) *ikepolicies.LifetimeCreateOpts {
+	for _, raw := range lifetime.List() {
+		rawMap := raw.(map[string]interface{})
+		return &ikepolicies.LifetimeCreateOpts{
+			Units: ikepolicies.Unit(rawMap["units"].(string)),
+			Value: rawMap["value"].(int),
+		}
+	}
+
+	return nil
+}
+
+func waitForVpnIKEPolicyDeletion(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		policy, err := ikepolicies.Get(networkingClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud IKE policy %s", id)
+				return policy, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		return policy, "DELETING", nil
+	}
+}
@@ -0,0 +1,117 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
+)
+
+func TestAccVpnIKEPolicyV2_basic(t *testing.T) {
+	var policy ikepolicies.Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnIKEPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnIKEPolicyV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnIKEPolicyV2Exists("opentelekomcloud_vpnaas_ike_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ike_policy_v2.policy_1", "auth_algorithm", "sha1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ike_policy_v2.policy_1", "pfs", "group5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpnIKEPolicyV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnIKEPolicyV2Exists("opentelekomcloud_vpnaas_ike_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ike_policy_v2.policy_1", "name", "policy_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ike_policy_v2.policy_1", "auth_algorithm", "sha2-256"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ike_policy_v2.policy_1", "ike_version", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpnIKEPolicyV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpnaas_ike_policy_v2" {
+			continue
+		}
+
+		_, err = ikepolicies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("IKE policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpnIKEPolicyV2Exists(n string, v *ikepolicies.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := ikepolicies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("IKE policy not found")
+		}
+
+		*v = *found
+
+		return nil
+	}
+}
+
+const testAccVpnIKEPolicyV2_basic = `
+resource "opentelekomcloud_vpnaas_ike_policy_v2" "policy_1" {
+  name = "policy_1"
+}
+`
+
+const testAccVpnIKEPolicyV2_update = `
+resource "opentelekomcloud_vpnaas_ike_policy_v2" "policy_1" {
+  name           = "policy_updated"
+  auth_algorithm = "sha2-256"
+  ike_version    = "v2"
+
+  lifetime {
+    units = "seconds"
+    value = 1200
+  }
+}
+`
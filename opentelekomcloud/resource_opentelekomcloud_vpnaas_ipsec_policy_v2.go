@@ -0,0 +1,320 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ipsecpolicies"
+)
+
+func resourceVpnIPSecPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnIPSecPolicyV2Create,
+		Read:   resourceVpnIPSecPolicyV2Read,
+		Update: resourceVpnIPSecPolicyV2Update,
+		Delete: resourceVpnIPSecPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auth_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sha1",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"md5", "sha1", "sha2-256", "sha2-384", "sha2-512"})
+				},
+			},
+			"encryption_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aes-128",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"3des", "aes-128", "aes-192", "aes-256"})
+				},
+			},
+			"pfs": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "group5",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"group1", "group2", "group5", "group14"})
+				},
+			},
+			"encapsulation_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "tunnel",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"tunnel", "transport"})
+				},
+			},
+			"transform_protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "esp",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"esp", "ah", "ah-esp"})
+				},
+			},
+			"lifetime": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"units": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVpnIPSecPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	createOpts := VpnIPSecPolicyCreateOpts{
+		ipsecpolicies.CreateOpts{
+			Name:                d.Get("name").(string),
+			Description:         d.Get("description").(string),
+			TenantID:            d.Get("tenant_id").(string),
+			AuthAlgorithm:       ipsecpolicies.AuthAlgorithm(d.Get("auth_algorithm").(string)),
+			EncryptionAlgorithm: ipsecpolicies.EncryptionAlgorithm(d.Get("encryption_algorithm").(string)),
+			PFS:                 ipsecpolicies.PFS(d.Get("pfs").(string)),
+			EncapsulationMode:   ipsecpolicies.EncapsulationMode(d.Get("encapsulation_mode").(string)),
+			TransformProtocol:   ipsecpolicies.TransformProtocol(d.Get("transform_protocol").(string)),
+			Lifetime:            resourceVpnIPSecPolicyV2LifetimeCreateOpts(d.Get("lifetime").(*schema.Set)),
+		},
+		MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create IPSec policy: %#v", createOpts)
+
+	policy, err := ipsecpolicies.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud IPSec policy: %s", err)
+	}
+
+	log.Printf("[DEBUG] IPSec policy %s created: %#v", policy.ID, policy)
+
+	d.SetId(policy.ID)
+
+	return resourceVpnIPSecPolicyV2Read(d, meta)
+}
+
+func resourceVpnIPSecPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Retrieve information about IPSec policy: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	policy, err := ipsecpolicies.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "IPSec policy")
+	}
+
+	log.Printf("[DEBUG] Read OpenTelekomCloud IPSec policy %s: %#v", d.Id(), policy)
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("auth_algorithm", policy.AuthAlgorithm)
+	d.Set("encryption_algorithm", policy.EncryptionAlgorithm)
+	d.Set("tenant_id", policy.TenantID)
+	d.Set("pfs", policy.PFS)
+	d.Set("encapsulation_mode", policy.EncapsulationMode)
+	d.Set("transform_protocol", policy.TransformProtocol)
+	d.Set("region", GetRegion(d, config))
+
+	lifetime := []map[string]interface{}{
+		{
+			"units": policy.Lifetime.Units,
+			"value": policy.Lifetime.Value,
+		},
+	}
+	if err := d.Set("lifetime", lifetime); err != nil {
+		log.Printf("[WARN] unable to set IPSec policy lifetime: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVpnIPSecPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts ipsecpolicies.UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+		hasChange = true
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+		hasChange = true
+	}
+
+	if d.HasChange("auth_algorithm") {
+		updateOpts.AuthAlgorithm = ipsecpolicies.AuthAlgorithm(d.Get("auth_algorithm").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("encryption_algorithm") {
+		updateOpts.EncryptionAlgorithm = ipsecpolicies.EncryptionAlgorithm(d.Get("encryption_algorithm").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("pfs") {
+		updateOpts.PFS = ipsecpolicies.PFS(d.Get("pfs").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("encapsulation_mode") {
+		updateOpts.EncapsulationMode = ipsecpolicies.EncapsulationMode(d.Get("encapsulation_mode").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("transform_protocol") {
+		updateOpts.TransformProtocol = ipsecpolicies.TransformProtocol(d.Get("transform_protocol").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("lifetime") {
+		lifetime := resourceVpnIPSecPolicyV2LifetimeCreateOpts(d.Get("lifetime").(*schema.Set))
+		if lifetime != nil {
+			updateOpts.Lifetime = &ipsecpolicies.LifetimeUpdateOpts{
+				Units: lifetime.Units,
+				Value: lifetime.Value,
+			}
+			hasChange = true
+		}
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating IPSec policy %s with options: %#v", d.Id(), updateOpts)
+
+		_, err = ipsecpolicies.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud IPSec policy: %s", err)
+		}
+	}
+
+	return resourceVpnIPSecPolicyV2Read(d, meta)
+}
+
+func resourceVpnIPSecPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Destroy IPSec policy: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	err = ipsecpolicies.Delete(networkingClient, d.Id()).Err
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenTelekomCloud IPSec policy")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpnIPSecPolicyDeletion(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for IPSec policy (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceVpnIPSecPolicyV2LifetimeCreateOpts(lifetime *schema.Set) *ipsecpolicies.LifetimeCreateOpts {
+	for _, raw := range lifetime.List() {
+		rawMap := raw.(map[string]interface{})
+		return &ipsecpolicies.LifetimeCreateOpts{
+			Units: ipsecpolicies.Unit(rawMap["units"].(string)),
+			Value: rawMap["value"].(int),
+		}
+	}
+
+	return nil
+}
+
+func waitForVpnIPSecPolicyDeletion(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		policy, err := ipsecpolicies.Get(networkingClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud IPSec policy %s", id)
+				return policy, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		return policy, "DELETING", nil
+	}
+}
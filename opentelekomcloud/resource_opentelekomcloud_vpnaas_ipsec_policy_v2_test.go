@@ -0,0 +1,114 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ipsecpolicies"
+)
+
+func TestAccVpnIPSecPolicyV2_basic(t *testing.T) {
+	var policy ipsecpolicies.Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnIPSecPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnIPSecPolicyV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnIPSecPolicyV2Exists("opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1", "transform_protocol", "esp"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1", "encapsulation_mode", "tunnel"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpnIPSecPolicyV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnIPSecPolicyV2Exists("opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1", "name", "policy_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_ipsec_policy_v2.policy_1", "encryption_algorithm", "aes-256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpnIPSecPolicyV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpnaas_ipsec_policy_v2" {
+			continue
+		}
+
+		_, err = ipsecpolicies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("IPSec policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpnIPSecPolicyV2Exists(n string, v *ipsecpolicies.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := ipsecpolicies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("IPSec policy not found")
+		}
+
+		*v = *found
+
+		return nil
+	}
+}
+
+const testAccVpnIPSecPolicyV2_basic = `
+resource "opentelekomcloud_vpnaas_ipsec_policy_v2" "policy_1" {
+  name = "policy_1"
+}
+`
+
+const testAccVpnIPSecPolicyV2_update = `
+resource "opentelekomcloud_vpnaas_ipsec_policy_v2" "policy_1" {
+  name                 = "policy_updated"
+  encryption_algorithm = "aes-256"
+
+  lifetime {
+    units = "seconds"
+    value = 1200
+  }
+}
+`
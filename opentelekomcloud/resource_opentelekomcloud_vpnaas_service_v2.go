@@ -0,0 +1,284 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/services"
+)
+
+func resourceVpnServiceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnServiceV2Create,
+		Read:   resourceVpnServiceV2Read,
+		Update: resourceVpnServiceV2Update,
+		Delete: resourceVpnServiceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"router_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_v6_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_v4_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVpnServiceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+
+	createOpts := VpnServiceCreateOpts{
+		services.CreateOpts{
+			Name:         d.Get("name").(string),
+			Description:  d.Get("description").(string),
+			AdminStateUp: &adminStateUp,
+			TenantID:     d.Get("tenant_id").(string),
+			SubnetID:     d.Get("subnet_id").(string),
+			RouterID:     d.Get("router_id").(string),
+		},
+		MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create VPN service: %#v", createOpts)
+
+	service, err := services.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud VPN service: %s", err)
+	}
+
+	// A VPN service stays PENDING_CREATE until it has a site connection.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NOT_CREATED"},
+		Target:     []string{"PENDING_CREATE", "ACTIVE"},
+		Refresh:    waitForVpnServiceActive(networkingClient, service.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for VPN service (%s) to be created: %s", service.ID, err)
+	}
+
+	log.Printf("[DEBUG] VPN service %s created: %#v", service.ID, service)
+
+	d.SetId(service.ID)
+
+	return resourceVpnServiceV2Read(d, meta)
+}
+
+func resourceVpnServiceV2Read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Retrieve information about VPN service: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	service, err := services.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN service")
+	}
+
+	log.Printf("[DEBUG] Read OpenTelekomCloud VPN service %s: %#v", d.Id(), service)
+
+	d.Set("name", service.Name)
+	d.Set("description", service.Description)
+	d.Set("subnet_id", service.SubnetID)
+	d.Set("admin_state_up", service.AdminStateUp)
+	d.Set("tenant_id", service.TenantID)
+	d.Set("router_id", service.RouterID)
+	d.Set("status", service.Status)
+	d.Set("external_v6_ip", service.ExternalV6IP)
+	d.Set("external_v4_ip", service.ExternalV4IP)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpnServiceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts services.UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+		hasChange = true
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+		hasChange = true
+	}
+
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+		hasChange = true
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating VPN service %s with options: %#v", d.Id(), updateOpts)
+
+		_, err = services.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud VPN service: %s", err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"PENDING_UPDATE"},
+			Target:     []string{"PENDING_CREATE", "ACTIVE"},
+			Refresh:    waitForVpnServiceActive(networkingClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      0,
+			MinTimeout: 2 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for VPN service (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVpnServiceV2Read(d, meta)
+}
+
+func resourceVpnServiceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Destroy VPN service: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	err = services.Delete(networkingClient, d.Id()).Err
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenTelekomCloud VPN service")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpnServiceDeletion(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for VPN service (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForVpnServiceActive(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := services.Get(networkingClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if service.Status == "ERROR" {
+			return service, service.Status, fmt.Errorf("VPN service %s is in ERROR state", id)
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud VPN service %s status: %s", id, service.Status)
+		return service, service.Status, nil
+	}
+}
+
+func waitForVpnServiceDeletion(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := services.Get(networkingClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud VPN service %s", id)
+				return service, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud VPN service %s still exists", id)
+		return service, "DELETING", nil
+	}
+}
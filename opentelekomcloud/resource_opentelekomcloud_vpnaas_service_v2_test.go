@@ -0,0 +1,125 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/services"
+)
+
+func TestAccVpnServiceV2_basic(t *testing.T) {
+	var service services.Service
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnServiceV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnServiceV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnServiceV2Exists("opentelekomcloud_vpnaas_service_v2.service_1", &service),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_vpnaas_service_v2.service_1", "router_id",
+						"opentelekomcloud_networking_router_v2.router_1", "id"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_service_v2.service_1", "admin_state_up", "true"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_service_v2.service_1", "status", "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpnServiceV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnServiceV2Exists("opentelekomcloud_vpnaas_service_v2.service_1", &service),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_service_v2.service_1", "name", "vpn_service_updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpnServiceV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpnaas_service_v2" {
+			continue
+		}
+
+		_, err = services.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("VPN service (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpnServiceV2Exists(n string, service *services.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := services.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("VPN service not found")
+		}
+
+		*service = *found
+
+		return nil
+	}
+}
+
+var testAccVpnServiceV2_router = fmt.Sprintf(`
+resource "opentelekomcloud_networking_router_v2" "router_1" {
+  name             = "router_1"
+  admin_state_up   = "true"
+  external_gateway = "%s"
+}
+`, OS_EXTGW_ID)
+
+var testAccVpnServiceV2_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpnaas_service_v2" "service_1" {
+  name           = "vpn_service"
+  router_id      = "${opentelekomcloud_networking_router_v2.router_1.id}"
+  admin_state_up = "true"
+}
+`, testAccVpnServiceV2_router)
+
+var testAccVpnServiceV2_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpnaas_service_v2" "service_1" {
+  name           = "vpn_service_updated"
+  router_id      = "${opentelekomcloud_networking_router_v2.router_1.id}"
+  admin_state_up = "true"
+}
+`, testAccVpnServiceV2_router)
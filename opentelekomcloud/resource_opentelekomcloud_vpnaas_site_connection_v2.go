@@ -0,0 +1,461 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/siteconnections"
+)
+
+func resourceVpnSiteConnectionV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnSiteConnectionV2Create,
+		Read:   resourceVpnSiteConnectionV2Read,
+		Update: resourceVpnSiteConnectionV2Update,
+		Delete: resourceVpnSiteConnectionV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+			"ikepolicy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ipsecpolicy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpnservice_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"local_ep_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"peer_ep_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"local_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"peer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"peer_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"peer_cidrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"psk": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"initiator": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"bi-directional", "response-only"})
+				},
+			},
+			"mtu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"dpd": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								return ValidateStringList(v, k, []string{"clear", "hold", "restart", "disabled", "restart-by-peer"})
+							},
+						},
+						"timeout": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+						},
+						"interval": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVpnSiteConnectionV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+
+	createOpts := VpnSiteConnectionCreateOpts{
+		siteconnections.CreateOpts{
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			AdminStateUp:   &adminStateUp,
+			TenantID:       d.Get("tenant_id").(string),
+			IKEPolicyID:    d.Get("ikepolicy_id").(string),
+			IPSecPolicyID:  d.Get("ipsecpolicy_id").(string),
+			VPNServiceID:   d.Get("vpnservice_id").(string),
+			LocalEPGroupID: d.Get("local_ep_group_id").(string),
+			PeerEPGroupID:  d.Get("peer_ep_group_id").(string),
+			LocalID:        d.Get("local_id").(string),
+			PeerID:         d.Get("peer_id").(string),
+			PeerAddress:    d.Get("peer_address").(string),
+			PeerCIDRs:      resourceVpnSiteConnectionV2PeerCIDRs(d),
+			PSK:            d.Get("psk").(string),
+			Initiator:      siteconnections.Initiator(d.Get("initiator").(string)),
+			MTU:            d.Get("mtu").(int),
+			DPD:            resourceVpnSiteConnectionV2DPDCreateOpts(d.Get("dpd").(*schema.Set)),
+		},
+		MapValueSpecs(d),
+	}
+
+	// Don't log the pre-shared key
+	log.Printf("[DEBUG] Create VPN site connection %s for VPN service %s", createOpts.Name, createOpts.VPNServiceID)
+
+	conn, err := siteconnections.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud VPN site connection: %s", err)
+	}
+
+	// A connection is DOWN until its peer is up, which is a valid result.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NOT_CREATED", "PENDING_CREATE"},
+		Target:     []string{"ACTIVE", "DOWN"},
+		Refresh:    waitForVpnSiteConnectionActive(networkingClient, conn.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for VPN site connection (%s) to be created: %s", conn.ID, err)
+	}
+
+	log.Printf("[DEBUG] VPN site connection %s created", conn.ID)
+
+	d.SetId(conn.ID)
+
+	return resourceVpnSiteConnectionV2Read(d, meta)
+}
+
+func resourceVpnSiteConnectionV2Read(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Retrieve information about VPN site connection: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	conn, err := siteconnections.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN site connection")
+	}
+
+	log.Printf("[DEBUG] Read OpenTelekomCloud VPN site connection %s with status %s", d.Id(), conn.Status)
+
+	d.Set("name", conn.Name)
+	d.Set("description", conn.Description)
+	d.Set("admin_state_up", conn.AdminStateUp)
+	d.Set("tenant_id", conn.TenantID)
+	d.Set("ikepolicy_id", conn.IKEPolicyID)
+	d.Set("ipsecpolicy_id", conn.IPSecPolicyID)
+	d.Set("vpnservice_id", conn.VPNServiceID)
+	d.Set("local_ep_group_id", conn.LocalEPGroupID)
+	d.Set("peer_ep_group_id", conn.PeerEPGroupID)
+	d.Set("local_id", conn.LocalID)
+	d.Set("peer_id", conn.PeerID)
+	d.Set("peer_address", conn.PeerAddress)
+	d.Set("peer_cidrs", conn.PeerCIDRs)
+	d.Set("psk", conn.PSK)
+	d.Set("initiator", conn.Initiator)
+	d.Set("mtu", conn.MTU)
+	d.Set("status", conn.Status)
+	d.Set("region", GetRegion(d, config))
+
+	dpd := []map[string]interface{}{
+		{
+			"action":   conn.DPD.Action,
+			"timeout":  conn.DPD.Timeout,
+			"interval": conn.DPD.Interval,
+		},
+	}
+	if err := d.Set("dpd", dpd); err != nil {
+		log.Printf("[WARN] unable to set VPN site connection dpd: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVpnSiteConnectionV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts siteconnections.UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+		hasChange = true
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+		hasChange = true
+	}
+
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+		hasChange = true
+	}
+
+	if d.HasChange("local_ep_group_id") {
+		updateOpts.LocalEPGroupID = d.Get("local_ep_group_id").(string)
+		hasChange = true
+	}
+
+	if d.HasChange("peer_ep_group_id") {
+		updateOpts.PeerEPGroupID = d.Get("peer_ep_group_id").(string)
+		hasChange = true
+	}
+
+	if d.HasChange("local_id") {
+		updateOpts.LocalID = d.Get("local_id").(string)
+		hasChange = true
+	}
+
+	if d.HasChange("peer_id") {
+		updateOpts.PeerID = d.Get("peer_id").(string)
+		hasChange = true
+	}
+
+	if d.HasChange("peer_address") {
+		updateOpts.PeerAddress = d.Get("peer_address").(string)
+		hasChange = true
+	}
+
+	if d.HasChange("peer_cidrs") {
+		updateOpts.PeerCIDRs = resourceVpnSiteConnectionV2PeerCIDRs(d)
+		hasChange = true
+	}
+
+	if d.HasChange("psk") {
+		updateOpts.PSK = d.Get("psk").(string)
+		hasChange = true
+	}
+
+	if d.HasChange("initiator") {
+		updateOpts.Initiator = siteconnections.Initiator(d.Get("initiator").(string))
+		hasChange = true
+	}
+
+	if d.HasChange("mtu") {
+		updateOpts.MTU = d.Get("mtu").(int)
+		hasChange = true
+	}
+
+	if d.HasChange("dpd") {
+		dpd := resourceVpnSiteConnectionV2DPDCreateOpts(d.Get("dpd").(*schema.Set))
+		if dpd != nil {
+			updateOpts.DPD = &siteconnections.DPDUpdateOpts{
+				Action:   dpd.Action,
+				Timeout:  dpd.Timeout,
+				Interval: dpd.Interval,
+			}
+			hasChange = true
+		}
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating VPN site connection %s", d.Id())
+
+		_, err = siteconnections.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud VPN site connection: %s", err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"PENDING_UPDATE"},
+			Target:     []string{"ACTIVE", "DOWN"},
+			Refresh:    waitForVpnSiteConnectionActive(networkingClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      0,
+			MinTimeout: 2 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for VPN site connection (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVpnSiteConnectionV2Read(d, meta)
+}
+
+func resourceVpnSiteConnectionV2Delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Destroy VPN site connection: %s", d.Id())
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	err = siteconnections.Delete(networkingClient, d.Id()).Err
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenTelekomCloud VPN site connection")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpnSiteConnectionDeletion(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for VPN site connection (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceVpnSiteConnectionV2PeerCIDRs(d *schema.ResourceData) []string {
+	rawCIDRs := d.Get("peer_cidrs").([]interface{})
+	cidrs := make([]string, len(rawCIDRs))
+	for i, raw := range rawCIDRs {
+		cidrs[i] = raw.(string)
+	}
+	return cidrs
+}
+
+func resourceVpnSiteConnectionV2DPDCreateOpts(dpd *schema.Set) *siteconnections.DPDCreateOpts {
+	for _, raw := range dpd.List() {
+		rawMap := raw.(map[string]interface{})
+		return &siteconnections.DPDCreateOpts{
+			Action:   siteconnections.Action(rawMap["action"].(string)),
+			Timeout:  rawMap["timeout"].(int),
+			Interval: rawMap["interval"].(int),
+		}
+	}
+
+	return nil
+}
+
+func waitForVpnSiteConnectionActive(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		conn, err := siteconnections.Get(networkingClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if conn.Status == "ERROR" {
+			return conn, conn.Status, fmt.Errorf("VPN site connection %s is in ERROR state", id)
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud VPN site connection %s status: %s", id, conn.Status)
+		return conn, conn.Status, nil
+	}
+}
+
+func waitForVpnSiteConnectionDeletion(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		conn, err := siteconnections.Get(networkingClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted OpenTelekomCloud VPN site connection %s", id)
+				return conn, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		return conn, "DELETING", nil
+	}
+}
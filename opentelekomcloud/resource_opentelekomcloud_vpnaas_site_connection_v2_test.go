@@ -0,0 +1,147 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/siteconnections"
+)
+
+func TestAccVpnSiteConnectionV2_basic(t *testing.T) {
+	var conn siteconnections.Connection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnSiteConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpnSiteConnectionV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnSiteConnectionV2Exists("opentelekomcloud_vpnaas_site_connection_v2.conn_1", &conn),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_vpnaas_site_connection_v2.conn_1", "vpnservice_id",
+						"opentelekomcloud_vpnaas_service_v2.service_1", "id"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpnaas_site_connection_v2.conn_1", "peer_address", "172.24.4.233"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpnSiteConnectionV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpnaas_site_connection_v2" {
+			continue
+		}
+
+		_, err = siteconnections.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("VPN site connection (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpnSiteConnectionV2Exists(n string, v *siteconnections.Connection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := siteconnections.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("VPN site connection not found")
+		}
+
+		*v = *found
+
+		return nil
+	}
+}
+
+var testAccVpnSiteConnectionV2_basic = fmt.Sprintf(`
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${opentelekomcloud_networking_network_v2.network_1.id}"
+}
+
+resource "opentelekomcloud_networking_router_v2" "router_1" {
+  name             = "router_1"
+  external_gateway = "%s"
+}
+
+resource "opentelekomcloud_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${opentelekomcloud_networking_router_v2.router_1.id}"
+  subnet_id = "${opentelekomcloud_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "opentelekomcloud_vpnaas_service_v2" "service_1" {
+  router_id = "${opentelekomcloud_networking_router_v2.router_1.id}"
+
+  depends_on = ["opentelekomcloud_networking_router_interface_v2.router_interface_1"]
+}
+
+resource "opentelekomcloud_vpnaas_ike_policy_v2" "policy_1" {
+  name = "ike_policy_1"
+}
+
+resource "opentelekomcloud_vpnaas_ipsec_policy_v2" "policy_2" {
+  name = "ipsec_policy_1"
+}
+
+resource "opentelekomcloud_vpnaas_endpoint_group_v2" "group_1" {
+  type      = "cidr"
+  endpoints = ["10.0.0.24/24", "10.0.0.25/24"]
+}
+
+resource "opentelekomcloud_vpnaas_endpoint_group_v2" "group_2" {
+  type      = "subnet"
+  endpoints = ["${opentelekomcloud_networking_subnet_v2.subnet_1.id}"]
+}
+
+resource "opentelekomcloud_vpnaas_site_connection_v2" "conn_1" {
+  name              = "connection_1"
+  ikepolicy_id      = "${opentelekomcloud_vpnaas_ike_policy_v2.policy_1.id}"
+  ipsecpolicy_id    = "${opentelekomcloud_vpnaas_ipsec_policy_v2.policy_2.id}"
+  vpnservice_id     = "${opentelekomcloud_vpnaas_service_v2.service_1.id}"
+  psk               = "secret"
+  peer_address      = "172.24.4.233"
+  peer_id           = "172.24.4.233"
+  local_ep_group_id = "${opentelekomcloud_vpnaas_endpoint_group_v2.group_2.id}"
+  peer_ep_group_id  = "${opentelekomcloud_vpnaas_endpoint_group_v2.group_1.id}"
+}
+`, OS_EXTGW_ID)
@@ -0,0 +1,160 @@
+package opentelekomcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/huaweicloud/golangsdk"
+)
+
+// OpenTelekomCloud issues temporary AK/SK with a security token for a
+// Keystone token, and for the agency of an ECS instance through its metadata
+// service. The providers below pass them to the S3 client, and retrieve new
+// ones shortly before they expire.
+
+const (
+	// s3KeystoneProviderName is the name of the provider of the temporary
+	// credentials of the Keystone token.
+	s3KeystoneProviderName = "OTCKeystoneProvider"
+
+	// s3ECSMetadataProviderName is the name of the provider of the temporary
+	// credentials of the ECS instance.
+	s3ECSMetadataProviderName = "OTCECSMetadataProvider"
+
+	// s3ECSMetadataEndpoint is the address of the ECS metadata service.
+	s3ECSMetadataEndpoint = "http://169.254.169.254"
+
+	// s3TemporaryCredentialsDuration is the lifetime in seconds requested for
+	// the temporary credentials of the Keystone token.
+	s3TemporaryCredentialsDuration = 3600
+
+	// s3TemporaryCredentialsWindow is how long before their expiry temporary
+	// credentials are retrieved again.
+	s3TemporaryCredentialsWindow = 5 * time.Minute
+)
+
+// s3TemporaryCredentials are the temporary credentials returned by IAM and
+// by the ECS metadata service.
+type s3TemporaryCredentials struct {
+	Access        string `json:"access"`
+	Secret        string `json:"secret"`
+	SecurityToken string `json:"securitytoken"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+// value returns the credentials and their expiry.
+func (c *s3TemporaryCredentials) value(providerName string) (awsCredentials.Value, time.Time, error) {
+	if c.Access == "" || c.Secret == "" {
+		return awsCredentials.Value{ProviderName: providerName}, time.Time{},
+			fmt.Errorf("the temporary credentials are empty")
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, c.ExpiresAt)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: providerName}, time.Time{},
+			fmt.Errorf("invalid expiry of the temporary credentials %q: %s", c.ExpiresAt, err)
+	}
+
+	return awsCredentials.Value{
+		AccessKeyID:     c.Access,
+		SecretAccessKey: c.Secret,
+		SessionToken:    c.SecurityToken,
+		ProviderName:    providerName,
+	}, expiresAt, nil
+}
+
+// s3KeystoneCredentialsProvider retrieves temporary credentials for the
+// Keystone token of the provider from IAM.
+type s3KeystoneCredentialsProvider struct {
+	awsCredentials.Expiry
+
+	// Client is a client for the v3.0 IAM API.
+	Client *golangsdk.ServiceClient
+
+	// Duration is the requested lifetime of the credentials in seconds.
+	Duration int
+}
+
+func (p *s3KeystoneCredentialsProvider) Retrieve() (awsCredentials.Value, error) {
+	if p.Client == nil || p.Client.TokenID == "" {
+		return awsCredentials.Value{ProviderName: s3KeystoneProviderName},
+			awserr.New("NoKeystoneToken", "no Keystone token to retrieve temporary credentials for", nil)
+	}
+
+	body := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"token"},
+				"token": map[string]interface{}{
+					"duration_seconds": p.Duration,
+				},
+			},
+		},
+	}
+
+	var r struct {
+		Credential s3TemporaryCredentials `json:"credential"`
+	}
+	_, err := p.Client.Post(p.Client.ServiceURL("OS-CREDENTIAL", "securitytokens"), body, &r, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return awsCredentials.Value{ProviderName: s3KeystoneProviderName},
+			awserr.New("KeystoneCredentialsError", "failed to retrieve temporary credentials for the Keystone token", err)
+	}
+
+	value, expiresAt, err := r.Credential.value(s3KeystoneProviderName)
+	if err != nil {
+		return value, awserr.New("KeystoneCredentialsError", "failed to retrieve temporary credentials for the Keystone token", err)
+	}
+
+	p.SetExpiration(expiresAt, s3TemporaryCredentialsWindow)
+	return value, nil
+}
+
+// s3ECSMetadataCredentialsProvider retrieves the temporary credentials of
+// the agency of the ECS instance from its metadata service.
+type s3ECSMetadataCredentialsProvider struct {
+	awsCredentials.Expiry
+
+	// Client is the HTTP client for the metadata service.
+	Client *http.Client
+
+	// Endpoint is the address of the metadata service.
+	Endpoint string
+}
+
+func (p *s3ECSMetadataCredentialsProvider) Retrieve() (awsCredentials.Value, error) {
+	resp, err := p.Client.Get(p.Endpoint + "/openstack/latest/securitykey")
+	if err != nil {
+		return awsCredentials.Value{ProviderName: s3ECSMetadataProviderName},
+			awserr.New("ECSMetadataError", "failed to connect to the ECS metadata service", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return awsCredentials.Value{ProviderName: s3ECSMetadataProviderName},
+			awserr.New("ECSMetadataError", fmt.Sprintf(
+				"failed to retrieve temporary credentials from the ECS metadata service: %s", resp.Status), nil)
+	}
+
+	var r struct {
+		Credential s3TemporaryCredentials `json:"credential"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return awsCredentials.Value{ProviderName: s3ECSMetadataProviderName},
+			awserr.New("ECSMetadataError", "failed to decode the temporary credentials of the ECS metadata service", err)
+	}
+
+	value, expiresAt, err := r.Credential.value(s3ECSMetadataProviderName)
+	if err != nil {
+		return value, awserr.New("ECSMetadataError", "failed to retrieve temporary credentials from the ECS metadata service", err)
+	}
+
+	p.SetExpiration(expiresAt, s3TemporaryCredentialsWindow)
+	return value, nil
+}
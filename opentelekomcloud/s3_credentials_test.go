@@ -0,0 +1,156 @@
+package opentelekomcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/huaweicloud/golangsdk"
+)
+
+func TestS3ECSMetadataCredentialsProvider(t *testing.T) {
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openstack/latest/securitykey" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"credential": {"access": "AK", "secret": "SK", "securitytoken": "ST", "expires_at": "%s"}}`,
+			expiresAt.Format("2006-01-02T15:04:05.000000Z"))
+	}))
+	defer server.Close()
+
+	p := &s3ECSMetadataCredentialsProvider{
+		Client:   http.DefaultClient,
+		Endpoint: server.URL,
+	}
+
+	value, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if value.AccessKeyID != "AK" || value.SecretAccessKey != "SK" || value.SessionToken != "ST" {
+		t.Fatalf("Bad credentials: %#v", value)
+	}
+	if value.ProviderName != s3ECSMetadataProviderName {
+		t.Fatalf("Bad provider name: %s", value.ProviderName)
+	}
+	if p.IsExpired() {
+		t.Fatalf("Expected credentials that expire in an hour not to be expired")
+	}
+
+	expiresAt = time.Now().UTC().Add(s3TemporaryCredentialsWindow / 2)
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !p.IsExpired() {
+		t.Fatalf("Expected credentials that expire within the window to be expired")
+	}
+
+	p.Endpoint = server.URL + "/missing"
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatalf("Expected an error without an agency")
+	}
+}
+
+func TestS3KeystoneCredentialsProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v3.0/OS-CREDENTIAL/securitytokens" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("X-Auth-Token") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var body struct {
+			Auth struct {
+				Identity struct {
+					Methods []string `json:"methods"`
+					Token   struct {
+						Duration int `json:"duration_seconds"`
+					} `json:"token"`
+				} `json:"identity"`
+			} `json:"auth"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Auth.Identity.Token.Duration != 900 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"credential": {"access": "AK", "secret": "SK", "securitytoken": "ST", "expires_at": "%s"}}`,
+			time.Now().UTC().Add(15*time.Minute).Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{TokenID: "token"},
+		Endpoint:       server.URL + "/",
+		ResourceBase:   server.URL + "/v3.0/",
+	}
+	p := &s3KeystoneCredentialsProvider{
+		Client:   client,
+		Duration: 900,
+	}
+
+	value, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if value.AccessKeyID != "AK" || value.SecretAccessKey != "SK" || value.SessionToken != "ST" {
+		t.Fatalf("Bad credentials: %#v", value)
+	}
+	if p.IsExpired() {
+		t.Fatalf("Expected credentials that expire in 15 minutes not to be expired")
+	}
+
+	client.ProviderClient.TokenID = ""
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatalf("Expected an error without a Keystone token")
+	}
+}
+
+func TestAccS3Credentials_keystone(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("TF_ACC not set, skipping OpenTelekomCloud temporary credentials test.")
+	}
+	testAccPreCheckRequiredEnvVars(t)
+
+	config := &Config{
+		DomainName:       os.Getenv("OS_DOMAIN_NAME"),
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		Region:           OS_REGION_NAME,
+		TenantName:       os.Getenv("OS_TENANT_NAME"),
+		Token:            os.Getenv("OS_AUTH_TOKEN"),
+		Username:         os.Getenv("OS_USERNAME"),
+	}
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Error configuring the provider: %s", err)
+	}
+
+	s3conn, err := config.computeS3conn(OS_REGION_NAME)
+	if err != nil {
+		t.Fatalf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	if _, err := s3conn.ListBuckets(&s3.ListBucketsInput{}); err != nil {
+		t.Fatalf("Error listing buckets with temporary credentials: %s", err)
+	}
+
+	value, err := config.s3sess.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("Error getting the credentials: %s", err)
+	}
+	if value.ProviderName != s3KeystoneProviderName && value.ProviderName != s3ECSMetadataProviderName {
+		t.Fatalf("Expected temporary credentials, got credentials of %s", value.ProviderName)
+	}
+}
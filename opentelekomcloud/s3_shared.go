@@ -0,0 +1,418 @@
+package opentelekomcloud
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// s3MultipartThreshold is the default size in bytes above which objects are
+// uploaded in parts.
+const s3MultipartThreshold = 64 * 1024 * 1024
+
+// s3CopyObjectLimit is the maximum size in bytes of an object copied with a
+// single CopyObject request.
+const s3CopyObjectLimit = 5 * 1024 * 1024 * 1024
+
+// s3CopyPartSize is the size in bytes of the parts in which objects larger
+// than s3CopyObjectLimit are copied.
+const s3CopyPartSize = 1024 * 1024 * 1024
+
+// s3DeleteObjectsLimit is the maximum number of keys of a DeleteObjects
+// request.
+const s3DeleteObjectsLimit = 1000
+
+// OBS names its storage classes STANDARD, WARM and COLD, while its S3
+// compatible API expects the S3 names of the same classes.
+var s3StorageClasses = map[string]string{
+	"WARM": s3.TransitionStorageClassStandardIa,
+	"COLD": s3.TransitionStorageClassGlacier,
+}
+
+// s3StorageClass returns the S3 name of an OBS storage class.
+func s3StorageClass(class string) string {
+	if v, ok := s3StorageClasses[class]; ok {
+		return v
+	}
+	return class
+}
+
+// s3StorageClassName returns the OBS name of an S3 storage class.
+func s3StorageClassName(class string) string {
+	for k, v := range s3StorageClasses {
+		if v == class {
+			return k
+		}
+	}
+	return class
+}
+
+// s3BucketArn returns the ARN of a bucket, which is how the replication
+// destination is passed to the S3 API.
+func s3BucketArn(bucket string) string {
+	if strings.HasPrefix(bucket, "arn:") {
+		return bucket
+	}
+	return "arn:aws:s3:::" + bucket
+}
+
+// s3BucketNameFromArn returns the name of a bucket from its ARN.
+func s3BucketNameFromArn(arn string) string {
+	return strings.TrimPrefix(arn, "arn:aws:s3:::")
+}
+
+// s3UploadObject uploads an object with a single PutObject if its body is at
+// most threshold bytes, and in parts of threshold bytes otherwise. Bodies
+// that are files or byte readers are read part by part, not buffered.
+func s3UploadObject(s3conn *s3.S3, input *s3manager.UploadInput, threshold int64) (*s3manager.UploadOutput, error) {
+	uploader := s3manager.NewUploaderWithClient(s3conn, func(u *s3manager.Uploader) {
+		u.PartSize = threshold
+	})
+	return uploader.Upload(input)
+}
+
+// s3CopySource returns the x-amz-copy-source value of an object, its bucket
+// and key with each path segment escaped.
+func s3CopySource(bucket, key string) string {
+	segments := strings.Split(bucket+"/"+key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// s3CopyObjectMultipart performs the copy described by input in parts of
+// s3CopyPartSize bytes, for objects of size bytes which are too large for a
+// single CopyObject. It returns the version ID of the copy.
+func s3CopyObjectMultipart(s3conn *s3.S3, input *s3.CopyObjectInput, size int64) (*string, error) {
+	upload, err := s3conn.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket:                  input.Bucket,
+		Key:                     input.Key,
+		ACL:                     input.ACL,
+		Metadata:                input.Metadata,
+		CacheControl:            input.CacheControl,
+		ContentType:             input.ContentType,
+		ContentEncoding:         input.ContentEncoding,
+		ContentLanguage:         input.ContentLanguage,
+		ContentDisposition:      input.ContentDisposition,
+		ServerSideEncryption:    input.ServerSideEncryption,
+		SSEKMSKeyId:             input.SSEKMSKeyId,
+		WebsiteRedirectLocation: input.WebsiteRedirectLocation,
+		StorageClass:            input.StorageClass,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []*s3.CompletedPart
+	for start, n := int64(0), int64(1); start < size; start, n = start+s3CopyPartSize, n+1 {
+		end := start + s3CopyPartSize - 1
+		if end >= size {
+			end = size - 1
+		}
+
+		resp, err := s3conn.UploadPartCopy(&s3.UploadPartCopyInput{
+			Bucket:          input.Bucket,
+			Key:             input.Key,
+			CopySource:      input.CopySource,
+			CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+			PartNumber:      aws.Int64(n),
+			UploadId:        upload.UploadId,
+		})
+		if err != nil {
+			s3AbortMultipartUpload(s3conn, input.Bucket, input.Key, upload.UploadId)
+			return nil, err
+		}
+		parts = append(parts, &s3.CompletedPart{
+			ETag:       resp.CopyPartResult.ETag,
+			PartNumber: aws.Int64(n),
+		})
+	}
+
+	resp, err := s3conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          input.Bucket,
+		Key:             input.Key,
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		s3AbortMultipartUpload(s3conn, input.Bucket, input.Key, upload.UploadId)
+		return nil, err
+	}
+	return resp.VersionId, nil
+}
+
+func s3AbortMultipartUpload(s3conn *s3.S3, bucket, key, uploadID *string) {
+	_, err := s3conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadID,
+	})
+	if err != nil {
+		log.Printf("[WARN] Error aborting multipart upload %s of %s: %s",
+			aws.StringValue(uploadID), aws.StringValue(key), err)
+	}
+}
+
+// s3IsMultipartETag reports whether an ETag belongs to an object uploaded in
+// parts. Such ETags are not the MD5 of the object but end in "-" and the
+// number of parts.
+func s3IsMultipartETag(etag string) bool {
+	return strings.Contains(etag, "-")
+}
+
+// s3DeleteObjects deletes the objects keys of a bucket, in batches of the
+// size DeleteObjects allows.
+func s3DeleteObjects(s3conn *s3.S3, bucket string, keys []string) error {
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > s3DeleteObjectsLimit {
+			n = s3DeleteObjectsLimit
+		}
+
+		objects := make([]*s3.ObjectIdentifier, n)
+		for i, key := range keys[:n] {
+			objects[i] = &s3.ObjectIdentifier{Key: aws.String(key)}
+		}
+
+		resp, err := s3conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.Errors) > 0 {
+			e := resp.Errors[0]
+			return awserr.New(aws.StringValue(e.Code), fmt.Sprintf("failed to delete object %s: %s",
+				aws.StringValue(e.Key), aws.StringValue(e.Message)), nil)
+		}
+
+		keys = keys[n:]
+	}
+
+	return nil
+}
+
+// The vendored aws-sdk-go predates default bucket encryption, so its
+// requests are built with the types below.
+
+type s3ServerSideEncryptionConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*s3ServerSideEncryptionRule `locationName:"Rule" type:"list" flattened:"true"`
+}
+
+type s3ServerSideEncryptionRule struct {
+	_ struct{} `type:"structure"`
+
+	ApplyServerSideEncryptionByDefault *s3ServerSideEncryptionByDefault `type:"structure"`
+}
+
+type s3ServerSideEncryptionByDefault struct {
+	_ struct{} `type:"structure"`
+
+	KMSMasterKeyID *string `type:"string"`
+	SSEAlgorithm   *string `type:"string"`
+}
+
+type s3BucketEncryptionInput struct {
+	_ struct{} `type:"structure" payload:"ServerSideEncryptionConfiguration"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ServerSideEncryptionConfiguration *s3ServerSideEncryptionConfiguration `locationName:"ServerSideEncryptionConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type s3BucketEncryptionOutput struct {
+	_ struct{} `type:"structure" payload:"ServerSideEncryptionConfiguration"`
+
+	ServerSideEncryptionConfiguration *s3ServerSideEncryptionConfiguration `type:"structure"`
+}
+
+func s3GetBucketEncryption(s3conn *s3.S3, bucket string) (*s3ServerSideEncryptionConfiguration, error) {
+	op := &request.Operation{
+		Name:       "GetBucketEncryption",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?encryption",
+	}
+	output := &s3BucketEncryptionOutput{}
+	req := s3conn.NewRequest(op, &s3BucketEncryptionInput{Bucket: &bucket}, output)
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+	return output.ServerSideEncryptionConfiguration, nil
+}
+
+func s3PutBucketEncryption(s3conn *s3.S3, bucket string, configuration *s3ServerSideEncryptionConfiguration) error {
+	op := &request.Operation{
+		Name:       "PutBucketEncryption",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?encryption",
+	}
+	input := &s3BucketEncryptionInput{
+		Bucket:                            &bucket,
+		ServerSideEncryptionConfiguration: configuration,
+	}
+	req := s3conn.NewRequest(op, input, &struct{}{})
+	req.Handlers.Build.PushBack(s3ContentMD5)
+	return req.Send()
+}
+
+func s3DeleteBucketEncryption(s3conn *s3.S3, bucket string) error {
+	op := &request.Operation{
+		Name:       "DeleteBucketEncryption",
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/{Bucket}?encryption",
+	}
+	req := s3conn.NewRequest(op, &s3BucketEncryptionInput{Bucket: &bucket}, &struct{}{})
+	return req.Send()
+}
+
+// The default storage class and the quota of a bucket are OBS extensions of
+// the S3 API, so their requests are built with the types below too.
+
+type s3StoragePolicy struct {
+	_ struct{} `type:"structure"`
+
+	DefaultStorageClass *string `type:"string"`
+}
+
+type s3BucketStoragePolicyInput struct {
+	_ struct{} `type:"structure" payload:"StoragePolicy"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	StoragePolicy *s3StoragePolicy `locationName:"StoragePolicy" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type s3BucketStoragePolicyOutput struct {
+	_ struct{} `type:"structure" payload:"StoragePolicy"`
+
+	StoragePolicy *s3StoragePolicy `type:"structure"`
+}
+
+// s3GetBucketStorageClass returns the OBS name of the default storage class
+// of a bucket.
+func s3GetBucketStorageClass(s3conn *s3.S3, bucket string) (string, error) {
+	op := &request.Operation{
+		Name:       "GetBucketStoragePolicy",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?storagePolicy",
+	}
+	output := &s3BucketStoragePolicyOutput{}
+	req := s3conn.NewRequest(op, &s3BucketStoragePolicyInput{Bucket: &bucket}, output)
+	if err := req.Send(); err != nil {
+		return "", err
+	}
+	if output.StoragePolicy == nil {
+		return "", nil
+	}
+	return s3StorageClassName(aws.StringValue(output.StoragePolicy.DefaultStorageClass)), nil
+}
+
+// s3PutBucketStorageClass sets the default storage class of a bucket to the
+// OBS storage class class.
+func s3PutBucketStorageClass(s3conn *s3.S3, bucket, class string) error {
+	op := &request.Operation{
+		Name:       "PutBucketStoragePolicy",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?storagePolicy",
+	}
+	input := &s3BucketStoragePolicyInput{
+		Bucket: &bucket,
+		StoragePolicy: &s3StoragePolicy{
+			DefaultStorageClass: aws.String(s3StorageClass(class)),
+		},
+	}
+	req := s3conn.NewRequest(op, input, &struct{}{})
+	req.Handlers.Build.PushBack(s3ContentMD5)
+	return req.Send()
+}
+
+type s3Quota struct {
+	_ struct{} `type:"structure"`
+
+	StorageQuota *int64 `type:"long"`
+}
+
+type s3BucketQuotaInput struct {
+	_ struct{} `type:"structure" payload:"Quota"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	Quota *s3Quota `locationName:"Quota" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type s3BucketQuotaOutput struct {
+	_ struct{} `type:"structure" payload:"Quota"`
+
+	Quota *s3Quota `type:"structure"`
+}
+
+// s3GetBucketQuota returns the quota of a bucket in bytes, where 0 is no
+// quota.
+func s3GetBucketQuota(s3conn *s3.S3, bucket string) (int64, error) {
+	op := &request.Operation{
+		Name:       "GetBucketQuota",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?quota",
+	}
+	output := &s3BucketQuotaOutput{}
+	req := s3conn.NewRequest(op, &s3BucketQuotaInput{Bucket: &bucket}, output)
+	if err := req.Send(); err != nil {
+		return 0, err
+	}
+	if output.Quota == nil {
+		return 0, nil
+	}
+	return aws.Int64Value(output.Quota.StorageQuota), nil
+}
+
+// s3PutBucketQuota sets the quota of a bucket in bytes, where 0 removes it.
+func s3PutBucketQuota(s3conn *s3.S3, bucket string, quota int64) error {
+	op := &request.Operation{
+		Name:       "PutBucketQuota",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?quota",
+	}
+	input := &s3BucketQuotaInput{
+		Bucket: &bucket,
+		Quota: &s3Quota{
+			StorageQuota: aws.Int64(quota),
+		},
+	}
+	req := s3conn.NewRequest(op, input, &struct{}{})
+	req.Handlers.Build.PushBack(s3ContentMD5)
+	return req.Send()
+}
+
+// s3ContentMD5 sets the Content-MD5 header, which S3 requires for requests
+// that put bucket configurations.
+func s3ContentMD5(r *request.Request) {
+	h := md5.New()
+
+	if _, err := io.Copy(h, r.Body); err != nil {
+		r.Error = awserr.New("ContentMD5", "failed to read body", err)
+		return
+	}
+	if _, err := r.Body.Seek(0, 0); err != nil {
+		r.Error = awserr.New("ContentMD5", "failed to seek body", err)
+		return
+	}
+
+	r.HTTPRequest.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"gopkg.in/yaml.v2"
 )
 
@@ -19,6 +20,16 @@ func flattenStringList(list []*string) []interface{} {
 	return vs
 }
 
+// Takes the result of flatmap.Expand for an array of strings
+// and returns a []*string
+func expandStringList(configured []interface{}) []*string {
+	vs := make([]*string, 0, len(configured))
+	for _, v := range configured {
+		vs = append(vs, aws.String(v.(string)))
+	}
+	return vs
+}
+
 func pointersMapToStringList(pointers map[string]*string) map[string]interface{} {
 	list := make(map[string]interface{}, len(pointers))
 	for i, v := range pointers {
@@ -27,6 +38,15 @@ func pointersMapToStringList(pointers map[string]*string) map[string]interface{}
 	return list
 }
 
+// Takes a map of strings from the schema and returns a map[string]*string
+func stringMapToPointers(m map[string]interface{}) map[string]*string {
+	list := make(map[string]*string, len(m))
+	for i, v := range m {
+		list[i] = aws.String(v.(string))
+	}
+	return list
+}
+
 // a convenience wrapper type for the schema.Set map[string]interface{}
 // Set operations only alter the underlying map if the value is not nil
 type setMap map[string]interface{}
@@ -14,12 +14,19 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/endpointgroups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ipsecpolicies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/services"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/siteconnections"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/dns/v2/recordsets"
 	"github.com/huaweicloud/golangsdk/openstack/dns/v2/zones"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v1/eips"
+	vpcsubnets "github.com/huaweicloud/golangsdk/openstack/networking/v1/subnets"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/fwaas_v2/firewall_groups"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/fwaas_v2/policies"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/fwaas_v2/routerinsertion"
@@ -328,6 +335,99 @@ func (opts SubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error)
 	return b, nil
 }
 
+// VpcSubnetExtraDhcpOpt represents an extra DHCP option of a VPC subnet.
+// A nil OptValue removes the option.
+type VpcSubnetExtraDhcpOpt struct {
+	OptName  string  `json:"opt_name" required:"true"`
+	OptValue *string `json:"opt_value"`
+}
+
+// VpcSubnetCreateOpts represents the attributes used when creating a new VPC subnet.
+type VpcSubnetCreateOpts struct {
+	vpcsubnets.CreateOpts
+	IPv6Enable    bool                    `json:"ipv6_enable,omitempty"`
+	ExtraDhcpOpts []VpcSubnetExtraDhcpOpt `json:"extra_dhcp_opts,omitempty"`
+}
+
+// ToSubnetCreateMap casts a CreateOpts struct to a map.
+// It overrides subnets.ToSubnetCreateMap to add the IPv6 and DHCP option fields.
+func (opts VpcSubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpcSubnetUpdateOpts represents the attributes used when updating a VPC subnet.
+type VpcSubnetUpdateOpts struct {
+	vpcsubnets.UpdateOpts
+	IPv6Enable    bool                    `json:"ipv6_enable,omitempty"`
+	ExtraDhcpOpts []VpcSubnetExtraDhcpOpt `json:"extra_dhcp_opts,omitempty"`
+}
+
+// ToSubnetUpdateMap casts an UpdateOpts struct to a map.
+// It overrides subnets.ToSubnetUpdateMap to add the IPv6 and DHCP option fields.
+func (opts VpcSubnetUpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpnEndpointGroupCreateOpts represents the attributes used when creating a new VPN endpoint group.
+type VpnEndpointGroupCreateOpts struct {
+	endpointgroups.CreateOpts
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToEndpointGroupCreateMap casts a CreateOpts struct to a map.
+// It overrides endpointgroups.ToEndpointGroupCreateMap to add the ValueSpecs field.
+func (opts VpnEndpointGroupCreateOpts) ToEndpointGroupCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "endpoint_group")
+}
+
+// VpnIKEPolicyCreateOpts represents the attributes used when creating a new VPN IKE policy.
+type VpnIKEPolicyCreateOpts struct {
+	ikepolicies.CreateOpts
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToPolicyCreateMap casts a CreateOpts struct to a map.
+// It overrides ikepolicies.ToPolicyCreateMap to add the ValueSpecs field.
+func (opts VpnIKEPolicyCreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "ikepolicy")
+}
+
+// VpnIPSecPolicyCreateOpts represents the attributes used when creating a new VPN IPSec policy.
+type VpnIPSecPolicyCreateOpts struct {
+	ipsecpolicies.CreateOpts
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToPolicyCreateMap casts a CreateOpts struct to a map.
+// It overrides ipsecpolicies.ToPolicyCreateMap to add the ValueSpecs field.
+func (opts VpnIPSecPolicyCreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "ipsecpolicy")
+}
+
+// VpnServiceCreateOpts represents the attributes used when creating a new VPN service.
+type VpnServiceCreateOpts struct {
+	services.CreateOpts
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToServiceCreateMap casts a CreateOpts struct to a map.
+// It overrides services.ToServiceCreateMap to add the ValueSpecs field.
+func (opts VpnServiceCreateOpts) ToServiceCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "vpnservice")
+}
+
+// VpnSiteConnectionCreateOpts represents the attributes used when creating a new VPN site connection.
+type VpnSiteConnectionCreateOpts struct {
+	siteconnections.CreateOpts
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToConnectionCreateMap casts a CreateOpts struct to a map.
+// It overrides siteconnections.ToConnectionCreateMap to add the ValueSpecs field.
+func (opts VpnSiteConnectionCreateOpts) ToConnectionCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "ipsec_site_connection")
+}
+
 // ZoneCreateOpts represents the attributes used when creating a new DNS zone.
 type ZoneCreateOpts struct {
 	zones.CreateOpts
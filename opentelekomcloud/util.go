@@ -142,3 +142,13 @@ func isResourceNotFound(err error) bool {
 	_, ok1 := err.(gophercloud.ErrDefault404)
 	return ok || ok1
 }
+
+// isEndpointOrResourceNotFound reports whether err is a 404 response or the
+// lack of a matching endpoint in the service catalog.
+func isEndpointOrResourceNotFound(err error) bool {
+	switch err.(type) {
+	case golangsdk.ErrEndpointNotFound, *golangsdk.ErrEndpointNotFound:
+		return true
+	}
+	return isResourceNotFound(err)
+}
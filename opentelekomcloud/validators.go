@@ -6,6 +6,8 @@ import (
 	"regexp"
 	"strings"
 	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 )
 
 func ValidateStringList(v interface{}, k string, l []string) (ws []string, errors []error) {
@@ -75,6 +77,53 @@ func validateS3BucketLifecycleExpirationDays(v interface{}, k string) (ws []stri
 	return
 }
 
+func validateS3BucketTransitionStorageClass(v interface{}, k string) (ws []string, errors []error) {
+	return ValidateStringList(v, k, []string{"WARM", "COLD"})
+}
+
+func validateS3StorageClass(v interface{}, k string) (ws []string, errors []error) {
+	return ValidateStringList(v, k, []string{"STANDARD", "WARM", "COLD"})
+}
+
+func validateS3BucketQuota(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be greater than or equal to 0", k))
+	}
+	return
+}
+
+func validateS3BucketObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf(
+				"%q keys must be lowercase, got %q", k, key))
+		}
+	}
+	return
+}
+
+// validateS3BucketObjectsPrefix requires the prefix of synced objects to be a
+// "directory", so that objects of sibling prefixes are not listed as stale.
+func validateS3BucketObjectsPrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" || value == "/" || !strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a non-empty prefix ending with \"/\", got %q", k, value))
+	}
+	return
+}
+
+func validateS3MultipartThreshold(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if int64(value) < s3manager.MinUploadPartSize {
+		errors = append(errors, fmt.Errorf(
+			"%q must be at least %d bytes", k, s3manager.MinUploadPartSize))
+	}
+	return
+}
+
 func validateS3BucketLifecycleRuleId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 255 {
@@ -136,3 +185,26 @@ func validateCIDR(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+func validateIPWithMask(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain an IP address with mask, got error parsing: %s", k, err))
+	}
+
+	return
+}
+
+func validateIPWithOptionalMask(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if net.ParseIP(value) != nil {
+		return
+	}
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain an IP address with or without mask, got %q", k, value))
+	}
+
+	return
+}
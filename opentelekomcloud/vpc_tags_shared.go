@@ -0,0 +1,113 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+)
+
+// VpcTag is a key/value tag of a VPC resource such as a VPC or a subnet. The
+// ECS tag API uses the same format.
+type VpcTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func vpcTagsURL(c *golangsdk.ServiceClient, resourceType, resourceID string, parts ...string) string {
+	return c.ServiceURL(append([]string{c.ProjectID, resourceType, resourceID, "tags"}, parts...)...)
+}
+
+func vpcTagsAction(c *golangsdk.ServiceClient, resourceType, resourceID, action string, tags []VpcTag) error {
+	b := map[string]interface{}{
+		"action": action,
+		"tags":   tags,
+	}
+	_, err := c.Post(vpcTagsURL(c, resourceType, resourceID, "action"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+// resourceVpcTagsV2Get returns the tags of a VPC resource as a map. The
+// client is the one returned by hwNetworkV2Client, resourceType is the
+// plural resource name used in the API path, e.g. "vpcs" or "subnets".
+func resourceVpcTagsV2Get(c *golangsdk.ServiceClient, resourceType, resourceID string) (map[string]string, error) {
+	var r struct {
+		Tags []VpcTag `json:"tags"`
+	}
+	_, err := c.Get(vpcTagsURL(c, resourceType, resourceID), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string, len(r.Tags))
+	for _, tag := range r.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	return tags, nil
+}
+
+// resourceVpcTagsV2Update applies the changes of the "tags" attribute of d to
+// the VPC resource. Tags which were removed or changed are deleted first, the
+// new and changed ones are created afterwards.
+func resourceVpcTagsV2Update(c *golangsdk.ServiceClient, d *schema.ResourceData, resourceType, resourceID string) error {
+	if !d.HasChange("tags") {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange("tags")
+	remove, create := diffVpcTags(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags %#v from %s %s", remove, resourceType, resourceID)
+		if err := vpcTagsAction(c, resourceType, resourceID, "delete", remove); err != nil {
+			return fmt.Errorf("Error removing tags from %s %s: %s", resourceType, resourceID, err)
+		}
+	}
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags %#v on %s %s", create, resourceType, resourceID)
+		if err := vpcTagsAction(c, resourceType, resourceID, "create", create); err != nil {
+			return fmt.Errorf("Error creating tags on %s %s: %s", resourceType, resourceID, err)
+		}
+	}
+
+	return nil
+}
+
+// diffVpcTags returns the tags which have to be removed and created to turn
+// the tags o into n. A changed value shows up in both lists.
+func diffVpcTags(o, n map[string]interface{}) (remove, create []VpcTag) {
+	for k, v := range o {
+		if nv, ok := n[k]; !ok || nv.(string) != v.(string) {
+			remove = append(remove, VpcTag{Key: k, Value: v.(string)})
+		}
+	}
+	for k, v := range n {
+		if ov, ok := o[k]; !ok || ov.(string) != v.(string) {
+			create = append(create, VpcTag{Key: k, Value: v.(string)})
+		}
+	}
+	sortVpcTags(remove)
+	sortVpcTags(create)
+	return remove, create
+}
+
+// vpcTagsMatch reports whether all the wanted tags are present in tags. It is
+// used by data sources to filter by the "tags" argument.
+func vpcTagsMatch(tags map[string]string, wanted map[string]interface{}) bool {
+	for k, v := range wanted {
+		if tv, ok := tags[k]; !ok || tv != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortVpcTags(tags []VpcTag) {
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+}